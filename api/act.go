@@ -0,0 +1,54 @@
+package api
+
+import (
+	"bio/auth"
+	"bio/service"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+func (ctrl *Controller) GetApplicationAct(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	id, ok := parsePathID(ctx, w, "application", applicationId)
+	if !ok {
+		return
+	}
+
+	file, err := ctrl.srvc.GetCompletionAct(ctx, user.ID, id)
+	switch err {
+	case nil:
+		defer file.Body.Close()
+
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="act-%s.pdf"`, id))
+		if file.Application.DoneAt != nil {
+			w.Header().Set("Last-Modified", file.Application.DoneAt.UTC().Format(http.TimeFormat))
+		}
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, file.Body)
+		if err != nil {
+			logger.Error().Err(err).Msg("write act")
+		}
+	case service.ErrNotFound:
+		WithNotFoundError(ctx, w, "act not found")
+	case service.ErrForbidden:
+		WithForbiddenError(ctx, w, "act is available to the creator, the performer and moderators")
+	default:
+		logger.Error().Err(err).Msg("get act")
+		WithInternalServerError(ctx, w, "")
+	}
+	return
+}
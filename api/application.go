@@ -11,9 +11,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"bio/pagination"
+	"bio/repository"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -26,6 +28,9 @@ func GetApplicationPaginationPolitics() pagination.PaginationPolitics {
 		OrderByMappgin: map[string]string{
 			"date_created": "date_created",
 			"status":       "status",
+			"priority":     repository.ApplicationPriorityRankExpr,
+			"relevance":    repository.ApplicationRelevanceSortKey,
+			"distance":     repository.ApplicationDistanceSortKey,
 		},
 	}
 }
@@ -56,9 +61,11 @@ func (ctrl *Controller) CreateApplication(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	application, err := srvc.CreateApplication(ctx, *createdApplication)
-	switch err {
-	case nil:
+	var fieldsErr *service.FieldsError
+
+	application, duplicates, err := srvc.CreateApplication(ctx, *createdApplication)
+	switch {
+	case err == nil:
 		err = repo.Commit()
 		if err != nil {
 			fmt.Println("cannot commit result: ", err)
@@ -66,7 +73,23 @@ func (ctrl *Controller) CreateApplication(w http.ResponseWriter, r *http.Request
 			return
 		}
 		res := ApplicationToAPI(application)
+		res.DuplicateCandidates = toPoint(arrayInArray(duplicates, DuplicateCandidateToAPI))
 		WithStatusOK(ctx, w, res)
+	case errors.Is(err, service.ErrUnknownPhoto):
+		repo.Rollback(ctx)
+		WithBadRequestError(ctx, w, err.Error())
+	case errors.Is(err, service.ErrNotFound):
+		repo.Rollback(ctx)
+		WithBadRequestError(ctx, w, "unknown subtype")
+	case errors.Is(err, service.ErrInvalidPremises):
+		repo.Rollback(ctx)
+		WithBadRequestError(ctx, w, "invalid premises")
+	case errors.Is(err, service.ErrArchived):
+		repo.Rollback(ctx)
+		WithBadRequestError(ctx, w, err.Error())
+	case errors.As(err, &fieldsErr):
+		repo.Rollback(ctx)
+		WithBadRequestError(ctx, w, fieldsErr.Error())
 	default:
 		repo.Rollback(ctx)
 		fmt.Println("create application: ", err)
@@ -119,6 +142,32 @@ func ApiToCreationApplication(ctx context.Context, body io.ReadCloser) (*service
 
 	appl.SubType = applSubType
 
+	buildingID, err := uuid.Parse(reqAppl.BuildingId)
+	if err != nil {
+		entry.Warn().Msg("empty building")
+		return nil, errors.New("empty building")
+	}
+
+	appl.BuildingID = &buildingID
+
+	if reqAppl.ApartmentId != nil {
+		apartmentID, err := uuid.Parse(*reqAppl.ApartmentId)
+		if err != nil {
+			entry.Warn().Msg("invalid apartment")
+			return nil, errors.New("invalid apartment")
+		}
+
+		appl.ApartmentID = &apartmentID
+	}
+
+	if reqAppl.Priority != nil {
+		appl.Priority = ApiToPriority(*reqAppl.Priority)
+		if appl.Priority == "" {
+			entry.Warn().Msg("invalid priority")
+			return nil, errors.New("invalid priority")
+		}
+	}
+
 	if reqAppl.PhotoIds != nil {
 		photoIDs, err := arrayInArrayWithError(*reqAppl.PhotoIds, uuid.Parse)
 		if err != nil {
@@ -128,6 +177,10 @@ func ApiToCreationApplication(ctx context.Context, body io.ReadCloser) (*service
 		appl.PhotoIDs = photoIDs
 	}
 
+	if reqAppl.Fields != nil {
+		appl.Fields = ApiToFields(reqAppl.Fields.AdditionalProperties)
+	}
+
 	return appl, nil
 }
 
@@ -138,13 +191,25 @@ func ApplicationToAPI(in *service.Application) specs.ApplicationResponse {
 		CreatorId: in.CreatorID.String(),
 		UpdatedAt: in.UpdatedAt,
 
-		Status:  StatusToApi(in.Status),
-		Type:    in.Type.String(),
-		Subtype: in.SubType.String(),
-		Text:    in.Text,
+		Status:   StatusToApi(in.Status),
+		Priority: specs.ApplicationPriority(in.Priority),
+		Type:     in.Type.String(),
+		Subtype:  in.SubType.String(),
+		Text:     in.Text,
 
 		PerformerAt: in.PerformerTime,
 
+		ResponseDueAt: in.ResponseDueAt,
+		DueAt:         in.DueAt,
+		Overdue:       in.IsOverdue(time.Now()),
+
+		Rating:  in.Rating,
+		Review:  in.Review,
+		RatedAt: in.RatedAt,
+
+		DoneAt:      in.DoneAt,
+		ReopenCount: in.ReopenCount,
+
 		PhotoIds: arrayInArray(in.PhotoIDs, func(v uuid.UUID) string { return v.String() }),
 	}
 
@@ -152,6 +217,49 @@ func ApplicationToAPI(in *service.Application) specs.ApplicationResponse {
 		out.PerformerId = toPoint(in.PerformerID.String())
 	}
 
+	if in.VisitSlot != nil {
+		out.VisitSlot = toPoint(VisitSlotToAPI(*in.VisitSlot))
+	}
+
+	if in.Fields != nil {
+		out.Fields = &specs.ApplicationResponse_Fields{AdditionalProperties: in.Fields}
+	}
+
+	if in.BuildingID != nil {
+		out.BuildingId = toPoint(in.BuildingID.String())
+	}
+
+	out.Latitude, out.Longitude = LocationToAPI(in.Location)
+
+	if in.ApartmentID != nil {
+		out.ApartmentId = toPoint(in.ApartmentID.String())
+	}
+
+	if in.DuplicateOfID != nil {
+		out.DuplicateOf = toPoint(in.DuplicateOfID.String())
+	}
+
+	if in.RecurrenceID != nil {
+		out.RecurrenceId = toPoint(in.RecurrenceID.String())
+	}
+
+	out.MaterialsCost = in.Costs.MaterialsCost
+	out.LabourHours = in.Costs.LabourHours
+
+	return out
+}
+
+func DuplicateCandidateToAPI(in *service.Application) specs.DuplicateCandidate {
+	out := specs.DuplicateCandidate{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		Status:    StatusToApi(in.Status),
+	}
+
+	if in.Text != "" {
+		out.Text = &in.Text
+	}
+
 	return out
 }
 
@@ -164,6 +272,9 @@ func StatusToApi(in service.ApplicationStatus) specs.ApplicationStatus {
 		service.ApplStatusCreated:    specs.ApplicationStatusCreated,
 		service.ApplStatusInProgress: specs.ApplicationStatusInProgress,
 		service.ApplStatusDone:       specs.ApplicationStatusDone,
+		service.ApplStatusCancelled:  specs.ApplicationStatusCancelled,
+		service.ApplStatusRejected:   specs.ApplicationStatusRejected,
+		service.ApplStatusReopened:   specs.ApplicationStatusReopened,
 	}[in]
 }
 
@@ -172,6 +283,18 @@ func ApiToStatus(in specs.ApplicationStatus) service.ApplicationStatus {
 		specs.ApplicationStatusCreated:    service.ApplStatusCreated,
 		specs.ApplicationStatusDone:       service.ApplStatusDone,
 		specs.ApplicationStatusInProgress: service.ApplStatusInProgress,
+		specs.ApplicationStatusCancelled:  service.ApplStatusCancelled,
+		specs.ApplicationStatusRejected:   service.ApplStatusRejected,
+		specs.ApplicationStatusReopened:   service.ApplStatusReopened,
+	}[in]
+}
+
+func ApiToPriority(in specs.ApplicationPriority) service.ApplicationPriority {
+	return map[specs.ApplicationPriority]service.ApplicationPriority{
+		specs.ApplicationPriorityLow:       service.ApplPriorityLow,
+		specs.ApplicationPriorityNormal:    service.ApplPriorityNormal,
+		specs.ApplicationPriorityHigh:      service.ApplPriorityHigh,
+		specs.ApplicationPriorityEmergency: service.ApplPriorityEmergency,
 	}[in]
 }
 
@@ -205,50 +328,64 @@ func (ctrl *Controller) ListApplications(w http.ResponseWriter, r *http.Request,
 	ctx := r.Context()
 	logger := zerolog.Ctx(ctx)
 
-	filter := service.ApplicationFilter{}
+	var fields map[string]interface{}
 
-	if params.PerformerId != nil {
-		performerId, err := uuid.Parse(*params.PerformerId)
+	if params.Fields != nil {
+		err := json.Unmarshal([]byte(*params.Fields), &fields)
 		if err != nil {
-			logger.Warn().Err(err).Msg("parse PerformerId")
-			WithBadRequestError(ctx, w, "invalid PerformerId")
+			logger.Warn().Err(err).Msg("parse Fields")
+			WithBadRequestError(ctx, w, "invalid Fields, a JSON object is expected")
 			return
 		}
+	}
 
-		filter.PerformerID = &performerId
+	filter, err := ApiToApplicationFilter(applicationFilterParams{
+		PerformerID:  params.PerformerId,
+		CreatorID:    params.CreatorId,
+		Type:         params.Type,
+		BuildingID:   params.BuildingId,
+		ApartmentID:  params.ApartmentId,
+		RecurrenceID: params.RecurrenceId,
+		Status:       params.Status,
+		Priority:     params.Priority,
+		Overdue:      params.Overdue,
+		Q:            params.Q,
+		Fields:       fields,
+	})
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse application filter")
+		WithBadRequestError(ctx, w, err.Error())
+		return
 	}
 
-	if params.CreatorId != nil {
-		creatorId, err := uuid.Parse(*params.CreatorId)
+	if params.Bbox != nil {
+		box, err := ApiToGeoBox(*params.Bbox)
 		if err != nil {
-			logger.Warn().Err(err).Msg("parse CreatorId")
-			WithBadRequestError(ctx, w, "invalid CreatorId")
+			logger.Warn().Err(err).Msg("parse Bbox")
+			WithBadRequestError(ctx, w, err.Error())
 			return
 		}
 
-		filter.CreatorID = &creatorId
+		filter.BoundingBox = box
 	}
 
-	if params.Status != nil {
-		status := ApiToStatus(*params.Status)
-		if status == "" {
-			logger.Warn().Msg("empty status")
-			WithBadRequestError(ctx, w, "invalid status")
-			return
-		}
-
-		filter.Status = status
+	point, err := ApiToLocation(params.Lat, params.Lon)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse Lat and Lon")
+		WithBadRequestError(ctx, w, err.Error())
+		return
 	}
 
-	if params.Type != nil {
-		typeId, err := uuid.Parse(*params.Type)
-		if err != nil {
-			logger.Warn().Err(err).Msg("parse Type")
-			WithBadRequestError(ctx, w, "invalid Type")
+	filter.Point = point
+
+	if params.Radius != nil {
+		if point == nil || *params.Radius < 0 {
+			logger.Warn().Msg("invalid radius")
+			WithBadRequestError(ctx, w, "radius requires lat and lon")
 			return
 		}
 
-		filter.Type = &typeId
+		filter.Radius = params.Radius
 	}
 
 	pgnPolitics, err := GetApplicationPaginationPolitics().MakePagination(params.Pagination, params.Sort)
@@ -291,10 +428,85 @@ func (ctrl *Controller) ListApplications(w http.ResponseWriter, r *http.Request,
 	return
 }
 
+// applicationFilterParams are the filter fields shared by the list of applications and the bulk operations.
+type applicationFilterParams struct {
+	PerformerID  *string
+	CreatorID    *string
+	Type         *string
+	BuildingID   *string
+	ApartmentID  *string
+	RecurrenceID *string
+	Status       *specs.ApplicationStatus
+	Priority     *specs.ApplicationPriority
+	Overdue      *bool
+	Q            *string
+	Fields       map[string]interface{}
+}
+
+// ApiToApplicationFilter parses the filter fields, the errors name the invalid field.
+func ApiToApplicationFilter(in applicationFilterParams) (service.ApplicationFilter, error) {
+	out := service.ApplicationFilter{
+		Overdue: in.Overdue,
+		Fields:  in.Fields,
+	}
+
+	ids := []struct {
+		name  string
+		value *string
+		dst   **uuid.UUID
+	}{
+		{"performer_id", in.PerformerID, &out.PerformerID},
+		{"creator_id", in.CreatorID, &out.CreatorID},
+		{"type", in.Type, &out.Type},
+		{"building_id", in.BuildingID, &out.BuildingID},
+		{"apartment_id", in.ApartmentID, &out.ApartmentID},
+		{"recurrence_id", in.RecurrenceID, &out.RecurrenceID},
+	}
+
+	for _, id := range ids {
+		if id.value == nil {
+			continue
+		}
+
+		parsed, err := uuid.Parse(*id.value)
+		if err != nil {
+			return out, errors.New("invalid " + id.name)
+		}
+		*id.dst = &parsed
+	}
+
+	if in.Status != nil {
+		out.Status = ApiToStatus(*in.Status)
+		if out.Status == "" {
+			return out, errors.New("invalid status")
+		}
+	}
+
+	if in.Priority != nil {
+		out.Priority = ApiToPriority(*in.Priority)
+		if out.Priority == "" {
+			return out, errors.New("invalid priority")
+		}
+	}
+
+	if in.Q != nil {
+		out.Query = strings.TrimSpace(*in.Q)
+	}
+
+	return out, nil
+}
+
 func (ctrl *Controller) UpdateApplication(w http.ResponseWriter, r *http.Request, applicationId string) {
 	ctx := r.Context()
 	logger := zerolog.Ctx(ctx)
 
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
 	id, err := uuid.Parse(applicationId)
 	if err != nil {
 		logger.Warn().Err(err).Msg("parse application id")
@@ -317,7 +529,7 @@ func (ctrl *Controller) UpdateApplication(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	application, err := srvc.UpdateApplication(ctx, *updatedApplication)
+	application, err := srvc.UpdateApplication(ctx, user.ID, *updatedApplication)
 	switch err {
 	case nil:
 		err = repo.Commit()
@@ -330,8 +542,7 @@ func (ctrl *Controller) UpdateApplication(w http.ResponseWriter, r *http.Request
 		WithStatusOK(ctx, w, res)
 	default:
 		repo.Rollback(ctx)
-		fmt.Println("update application: ", err)
-		WithInternalServerError(ctx, w, "")
+		withApplicationError(ctx, w, "update application", err)
 	}
 	return
 }
@@ -360,20 +571,82 @@ func ApiToUpdateApplication(ctx context.Context, body io.ReadCloser) (*service.A
 		appl.PerformerID = &performerID
 	}
 
+	if reqAppl.AddPhotoIds != nil {
+		photoIDs, err := arrayInArrayWithError(*reqAppl.AddPhotoIds, uuid.Parse)
+		if err != nil {
+			return nil, errors.New("parse photo")
+		}
+
+		appl.AddPhotoIDs = photoIDs
+	}
+
+	if reqAppl.RemovePhotoIds != nil {
+		photoIDs, err := arrayInArrayWithError(*reqAppl.RemovePhotoIds, uuid.Parse)
+		if err != nil {
+			return nil, errors.New("parse photo")
+		}
+
+		appl.RemovePhotoIDs = photoIDs
+	}
+
+	if reqAppl.Priority != nil {
+		appl.Priority = ApiToPriority(*reqAppl.Priority)
+		if appl.Priority == "" {
+			entry.Warn().Msg("invalid priority")
+			return nil, errors.New("invalid priority")
+		}
+	}
+
 	if reqAppl.Status != nil {
 		status := ApiToStatus(*reqAppl.Status)
+		if status == "" {
+			entry.Warn().Msg("invalid status")
+			return nil, errors.New("invalid status")
+		}
 
 		appl.Status = status
 	}
 
+	if reqAppl.Fields != nil {
+		appl.Fields = ApiToFields(reqAppl.Fields.AdditionalProperties)
+	}
+
 	return appl, nil
 }
 
+// ApiToFields keeps the empty object of custom fields not nil, so that the update clears the fields.
+func ApiToFields(in map[string]interface{}) map[string]interface{} {
+	if in == nil {
+		return map[string]interface{}{}
+	}
+
+	return in
+}
+
 func (ctrl *Controller) ListApplicationTypes(w http.ResponseWriter, r *http.Request, params specs.ListApplicationTypesParams) {
 	ctx := r.Context()
-	// logger := zerolog.Ctx(ctx)
 
-	filter := service.ApplicationFilter{}
+	pgnPolitics, err := GetApplicationTypePaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.ApplicationTypeFilter{
+		Pagination: pgnPolitics,
+	}
+
+	if params.Search != nil {
+		filter.Search = *params.Search
+	}
+
+	if params.IncludeArchived != nil {
+		filter.IncludeArchived = *params.IncludeArchived
+	}
+
+	if params.AcceptLanguage != nil {
+		filter.Locales = parseAcceptLanguage(*params.AcceptLanguage)
+	}
 
 	srvc, repo, err := ctrl.createTxService(ctx)
 	if err != nil {
@@ -408,25 +681,79 @@ func (ctrl *Controller) ListApplicationTypes(w http.ResponseWriter, r *http.Requ
 }
 
 func ApplicationTypeToAPI(in service.ApplicationType) specs.ApplicationType {
-	return specs.ApplicationType{
-		Id:    in.ID.String(),
-		Title: in.Title,
+	out := specs.ApplicationType{
+		Id:         in.ID.String(),
+		Title:      in.Title,
+		Position:   in.Position,
+		ArchivedAt: in.ArchivedAt,
+	}
+
+	if in.FieldsSchema != nil {
+		out.FieldsSchema = &specs.ApplicationType_FieldsSchema{AdditionalProperties: in.FieldsSchema}
+	}
+
+	if len(in.Titles) > 0 {
+		out.Titles = &specs.ApplicationType_Titles{AdditionalProperties: in.Titles}
 	}
+
+	return out
 }
 
 func ApplicationSubTypeToAPI(in service.ApplicationSubType) specs.ApplicationSubtype {
-	return specs.ApplicationSubtype{
+	out := specs.ApplicationSubtype{
 		Id:    in.ID.String(),
 		Title: in.Title,
 		Type:  in.Type.String(),
+
+		ResponseMinutes:   in.ResponseMinutes,
+		ResolutionMinutes: in.ResolutionMinutes,
+
+		Position:   in.Position,
+		ArchivedAt: in.ArchivedAt,
 	}
+
+	if len(in.Titles) > 0 {
+		out.Titles = &specs.ApplicationSubtype_Titles{AdditionalProperties: in.Titles}
+	}
+
+	return out
 }
 
 func (ctrl *Controller) ListApplicationSubTypes(w http.ResponseWriter, r *http.Request, params specs.ListApplicationSubTypesParams) {
 	ctx := r.Context()
-	// logger := zerolog.Ctx(ctx)
 
-	filter := service.ApplicationFilter{}
+	pgnPolitics, err := GetApplicationSubTypePaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.ApplicationSubTypeFilter{
+		Pagination: pgnPolitics,
+	}
+
+	if params.TypeId != nil {
+		typeID, err := uuid.Parse(*params.TypeId)
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Msg("parse TypeId")
+			WithBadRequestError(ctx, w, "invalid typeId")
+			return
+		}
+
+		filter.Type = &typeID
+	}
+
+	if params.Search != nil {
+		filter.Search = *params.Search
+	}
+
+	if params.IncludeArchived != nil {
+		filter.IncludeArchived = *params.IncludeArchived
+	}
+
+	if params.AcceptLanguage != nil {
+		filter.Locales = parseAcceptLanguage(*params.AcceptLanguage)
+	}
 
 	srvc, repo, err := ctrl.createTxService(ctx)
 	if err != nil {
@@ -0,0 +1,300 @@
+package api
+
+import (
+	"bio/pagination"
+	"bio/service"
+	"bio/specs"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+func GetApplicationTypePaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     200,
+		DefaultLimit: 100,
+		OrderByMappgin: map[string]string{
+			"position": "at.position",
+			"title":    "at.title",
+		},
+	}
+}
+
+func GetApplicationSubTypePaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     200,
+		DefaultLimit: 100,
+		OrderByMappgin: map[string]string{
+			"position": "ast.position",
+			"title":    "ast.title",
+		},
+	}
+}
+
+// withCategoryError maps errors of the application type and subtype operations to responses.
+func withCategoryError(ctx context.Context, w http.ResponseWriter, name string, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		WithNotFoundError(ctx, w, "not found")
+	case errors.Is(err, service.ErrForbidden):
+		WithForbiddenError(ctx, w, "application types are managed by moderators")
+	case errors.Is(err, service.ErrInvalidCategory):
+		WithBadRequestError(ctx, w, "title is required, translations need a locale like en or en-gb and a title, "+
+			"fields schema needs known types, valid patterns and consistent bounds, SLA may not be negative, reorder lists every item once")
+	default:
+		fmt.Println(name+": ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+}
+
+func (ctrl *Controller) CreateApplicationType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := specs.CreateApplicationTypePayload{}
+	if !decodeBody(ctx, w, r, "application type", &req) {
+		return
+	}
+
+	applType := service.ApplicationType{
+		ID:    uuid.New(),
+		Title: req.Title,
+	}
+
+	if req.FieldsSchema != nil {
+		applType.FieldsSchema = ApiToFields(req.FieldsSchema.AdditionalProperties)
+	}
+
+	if req.Titles != nil {
+		applType.Titles = req.Titles.AdditionalProperties
+	}
+
+	ctrl.handleEntityAction(w, r, withCategoryError, "create application type",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			created, err := srvc.CreateApplicationType(ctx, userID, applType)
+			if err != nil {
+				return nil, err
+			}
+			return ApplicationTypeToAPI(*created), nil
+		})
+}
+
+func (ctrl *Controller) UpdateApplicationType(w http.ResponseWriter, r *http.Request, typeId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "application type", typeId)
+	if !ok {
+		return
+	}
+
+	req := specs.UpdateApplicationTypePayload{}
+	if !decodeBody(ctx, w, r, "application type", &req) {
+		return
+	}
+
+	update := service.ApplicationTypeUpdate{
+		ID:    id,
+		Title: req.Title,
+	}
+
+	if req.FieldsSchema != nil {
+		update.FieldsSchema = ApiToFields(req.FieldsSchema.AdditionalProperties)
+	}
+
+	if req.ClearFieldsSchema != nil {
+		update.ClearFieldsSchema = *req.ClearFieldsSchema
+	}
+
+	if req.Titles != nil {
+		update.Titles = ApiToTitles(req.Titles.AdditionalProperties)
+	}
+
+	ctrl.handleEntityAction(w, r, withCategoryError, "update application type",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			updated, err := srvc.UpdateApplicationType(ctx, userID, update)
+			if err != nil {
+				return nil, err
+			}
+			return ApplicationTypeToAPI(*updated), nil
+		})
+}
+
+func (ctrl *Controller) SetApplicationTypeArchived(w http.ResponseWriter, r *http.Request, typeId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "application type", typeId)
+	if !ok {
+		return
+	}
+
+	req := specs.SetArchivedPayload{}
+	if !decodeBody(ctx, w, r, "archived", &req) {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withCategoryError, "set application type archived",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			updated, err := srvc.SetApplicationTypeArchived(ctx, userID, id, req.Archived)
+			if err != nil {
+				return nil, err
+			}
+			return ApplicationTypeToAPI(*updated), nil
+		})
+}
+
+func (ctrl *Controller) ReorderApplicationTypes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := specs.ReorderPayload{}
+	if !decodeBody(ctx, w, r, "order", &req) {
+		return
+	}
+
+	ids, err := arrayInArrayWithError(req.Ids, uuid.Parse)
+	if err != nil {
+		WithBadRequestError(ctx, w, "invalid id")
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withCategoryError, "reorder application types",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			return nil, srvc.ReorderApplicationTypes(ctx, userID, ids)
+		})
+}
+
+func (ctrl *Controller) CreateApplicationSubType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := specs.CreateApplicationSubTypePayload{}
+	if !decodeBody(ctx, w, r, "application subtype", &req) {
+		return
+	}
+
+	typeID, err := uuid.Parse(req.Type)
+	if err != nil {
+		WithBadRequestError(ctx, w, "invalid type")
+		return
+	}
+
+	subType := service.ApplicationSubType{
+		ID:                uuid.New(),
+		Title:             req.Title,
+		Type:              typeID,
+		ResponseMinutes:   req.ResponseMinutes,
+		ResolutionMinutes: req.ResolutionMinutes,
+	}
+
+	if req.Titles != nil {
+		subType.Titles = req.Titles.AdditionalProperties
+	}
+
+	ctrl.handleEntityAction(w, r, withCategoryError, "create application subtype",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			created, err := srvc.CreateApplicationSubType(ctx, userID, subType)
+			if err != nil {
+				return nil, err
+			}
+			return ApplicationSubTypeToAPI(*created), nil
+		})
+}
+
+func (ctrl *Controller) UpdateApplicationSubType(w http.ResponseWriter, r *http.Request, subtypeId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "application subtype", subtypeId)
+	if !ok {
+		return
+	}
+
+	req := specs.UpdateApplicationSubTypePayload{}
+	if !decodeBody(ctx, w, r, "application subtype", &req) {
+		return
+	}
+
+	update := service.ApplicationSubTypeUpdate{
+		ID:                id,
+		Title:             req.Title,
+		ResponseMinutes:   req.ResponseMinutes,
+		ResolutionMinutes: req.ResolutionMinutes,
+	}
+
+	if req.Titles != nil {
+		update.Titles = ApiToTitles(req.Titles.AdditionalProperties)
+	}
+
+	if req.ClearResponseMinutes != nil {
+		update.ClearResponseMinutes = *req.ClearResponseMinutes
+	}
+
+	if req.ClearResolutionMinutes != nil {
+		update.ClearResolutionMinutes = *req.ClearResolutionMinutes
+	}
+
+	ctrl.handleEntityAction(w, r, withCategoryError, "update application subtype",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			updated, err := srvc.UpdateApplicationSubType(ctx, userID, update)
+			if err != nil {
+				return nil, err
+			}
+			return ApplicationSubTypeToAPI(*updated), nil
+		})
+}
+
+func (ctrl *Controller) SetApplicationSubTypeArchived(w http.ResponseWriter, r *http.Request, subtypeId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "application subtype", subtypeId)
+	if !ok {
+		return
+	}
+
+	req := specs.SetArchivedPayload{}
+	if !decodeBody(ctx, w, r, "archived", &req) {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withCategoryError, "set application subtype archived",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			updated, err := srvc.SetApplicationSubTypeArchived(ctx, userID, id, req.Archived)
+			if err != nil {
+				return nil, err
+			}
+			return ApplicationSubTypeToAPI(*updated), nil
+		})
+}
+
+func (ctrl *Controller) ReorderApplicationSubTypes(w http.ResponseWriter, r *http.Request, typeId string) {
+	ctx := r.Context()
+
+	typeID, ok := parsePathID(ctx, w, "application type", typeId)
+	if !ok {
+		return
+	}
+
+	req := specs.ReorderPayload{}
+	if !decodeBody(ctx, w, r, "order", &req) {
+		return
+	}
+
+	ids, err := arrayInArrayWithError(req.Ids, uuid.Parse)
+	if err != nil {
+		WithBadRequestError(ctx, w, "invalid id")
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withCategoryError, "reorder application subtypes",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			return nil, srvc.ReorderApplicationSubTypes(ctx, userID, typeID, ids)
+		})
+}
+
+// ApiToTitles keeps an empty object of translations distinct from a missing one, so it removes all translations.
+func ApiToTitles(in map[string]string) map[string]string {
+	if in == nil {
+		return map[string]string{}
+	}
+
+	return in
+}
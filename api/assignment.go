@@ -0,0 +1,172 @@
+package api
+
+import (
+	"bio/auth"
+	"bio/service"
+	"bio/specs"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+type applicationAction func(ctx context.Context, srvc *service.Service, userID, applicationID uuid.UUID) (*service.Application, error)
+
+// handleApplicationAction runs an operation on a single application on behalf of the current user
+// and responds with the changed application.
+func (ctrl *Controller) handleApplicationAction(w http.ResponseWriter, r *http.Request, applicationId string, name string, action applicationAction) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	id, err := uuid.Parse(applicationId)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse application id")
+		WithBadRequestError(ctx, w, "invalid application id")
+		return
+	}
+
+	srvc, repo, err := ctrl.createTxService(ctx)
+	if err != nil {
+		fmt.Println("create tx: ", err)
+		WithInternalServerError(ctx, w, "")
+		return
+	}
+
+	application, err := action(ctx, srvc, user.ID, id)
+	switch err {
+	case nil:
+		err = repo.Commit()
+		if err != nil {
+			fmt.Println("cannot commit result: ", err)
+			WithInternalServerError(ctx, w, http.StatusText(http.StatusInternalServerError))
+			return
+		}
+		res := ApplicationToAPI(application)
+		WithStatusOK(ctx, w, res)
+	default:
+		repo.Rollback(ctx)
+		withApplicationError(ctx, w, name, err)
+	}
+}
+
+// withApplicationError maps errors of the application lifecycle to responses.
+func withApplicationError(ctx context.Context, w http.ResponseWriter, name string, err error) {
+	code, message := applicationErrorStatus(err)
+
+	switch code {
+	case http.StatusInternalServerError:
+		fmt.Println(name+": ", err)
+	case http.StatusConflict:
+		zerolog.Ctx(ctx).Warn().Err(err).Msg(name)
+	}
+
+	WithError(ctx, w, code, message)
+}
+
+// applicationErrorStatus maps errors of the application operations to the response code and message.
+func applicationErrorStatus(err error) (int, string) {
+	var (
+		transitionErr *service.TransitionError
+		assignmentErr *service.AssignmentError
+		fieldsErr     *service.FieldsError
+	)
+
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return http.StatusNotFound, "not found"
+	case errors.Is(err, service.ErrForbidden):
+		return http.StatusForbidden, "forbidden"
+	case errors.Is(err, service.ErrInvalidRating), errors.Is(err, service.ErrUnknownPhoto):
+		return http.StatusBadRequest, err.Error()
+	case errors.Is(err, service.ErrRatingNotAllowed):
+		return http.StatusConflict, err.Error()
+	case errors.Is(err, service.ErrInvalidSlot):
+		return http.StatusBadRequest, "slot does not belong to the performer or is in the past"
+	case errors.Is(err, service.ErrSlotConflict):
+		return http.StatusConflict, "slot is already booked or overlaps another visit"
+	case errors.Is(err, service.ErrSlotOffSchedule):
+		return http.StatusConflict, "slot is outside the working hours of the performer or on its absence"
+	case errors.Is(err, service.ErrChecklistIncomplete):
+		return http.StatusConflict, err.Error()
+	case errors.As(err, &fieldsErr):
+		return http.StatusBadRequest, fieldsErr.Error()
+	case errors.As(err, &transitionErr):
+		return http.StatusConflict, transitionErr.Error()
+	case errors.As(err, &assignmentErr):
+		return http.StatusConflict, assignmentErr.Error()
+	default:
+		return http.StatusInternalServerError, ""
+	}
+}
+
+func (ctrl *Controller) AssignApplicationPerformer(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctx := r.Context()
+
+	reqAssign := specs.AssignPerformerPayload{}
+
+	err := json.NewDecoder(r.Body).Decode(&reqAssign)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get assign json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return
+	}
+
+	performerID, err := uuid.Parse(reqAssign.PerformerId)
+	if err != nil {
+		WithBadRequestError(ctx, w, "invalid performer id")
+		return
+	}
+
+	ctrl.handleApplicationAction(w, r, applicationId, "assign performer",
+		func(ctx context.Context, srvc *service.Service, userID, id uuid.UUID) (*service.Application, error) {
+			return srvc.AssignPerformer(ctx, userID, id, performerID)
+		})
+}
+
+func (ctrl *Controller) UnassignApplicationPerformer(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctrl.handleApplicationAction(w, r, applicationId, "unassign performer",
+		func(ctx context.Context, srvc *service.Service, userID, id uuid.UUID) (*service.Application, error) {
+			return srvc.UnassignPerformer(ctx, userID, id)
+		})
+}
+
+func (ctrl *Controller) AcceptApplicationAssignment(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctrl.handleApplicationAction(w, r, applicationId, "accept assignment",
+		func(ctx context.Context, srvc *service.Service, userID, id uuid.UUID) (*service.Application, error) {
+			return srvc.AcceptAssignment(ctx, userID, id)
+		})
+}
+
+func (ctrl *Controller) DeclineApplicationAssignment(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctx := r.Context()
+
+	reqDecline := specs.DeclineAssignmentPayload{}
+
+	err := json.NewDecoder(r.Body).Decode(&reqDecline)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get decline json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return
+	}
+
+	if reqDecline.Reason == "" {
+		WithBadRequestError(ctx, w, "empty reason")
+		return
+	}
+
+	ctrl.handleApplicationAction(w, r, applicationId, "decline assignment",
+		func(ctx context.Context, srvc *service.Service, userID, id uuid.UUID) (*service.Application, error) {
+			return srvc.DeclineAssignment(ctx, userID, id, reqDecline.Reason)
+		})
+}
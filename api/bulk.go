@@ -0,0 +1,282 @@
+package api
+
+import (
+	"bio/auth"
+	"bio/service"
+	"bio/specs"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// withBulkError maps errors of planning a bulk operation to responses.
+func withBulkError(ctx context.Context, w http.ResponseWriter, name string, err error) {
+	switch {
+	case errors.Is(err, service.ErrForbidden):
+		WithForbiddenError(ctx, w, "bulk operations are available to moderators")
+	case errors.Is(err, service.ErrInvalidBulk):
+		WithBadRequestError(ctx, w, fmt.Sprintf(
+			"set a status, a performer or a priority and either ids or a filter with at least one criterion of at most %d applications",
+			service.MaxBulkApplications))
+	default:
+		fmt.Println(name+": ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+}
+
+// BulkUpdateApplications applies the change to every selected application. In the atomic mode
+// the first failure rolls back the whole operation and the report comes with 409, in the per item mode
+// every application is changed in its own transaction. The report lists the result of every application.
+func (ctrl *Controller) BulkUpdateApplications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	req := specs.BulkUpdateApplicationsPayload{}
+	if !decodeBody(ctx, w, r, "bulk update", &req) {
+		return
+	}
+
+	bulk, err := ApiToBulkUpdate(req)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse bulk update")
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	mode := specs.BulkUpdateApplicationsPayloadModeAtomic
+	if req.Mode != nil {
+		mode = *req.Mode
+	}
+
+	srvc, repo, err := ctrl.createTxService(ctx)
+	if err != nil {
+		fmt.Println("create tx: ", err)
+		WithInternalServerError(ctx, w, "")
+		return
+	}
+
+	ids, err := srvc.PlanBulkUpdate(ctx, user.ID, bulk)
+	if err != nil {
+		repo.Rollback(ctx)
+		withBulkError(ctx, w, "plan bulk update", err)
+		return
+	}
+
+	var items []specs.BulkUpdateItemResult
+
+	code := http.StatusOK
+
+	switch mode {
+	case specs.BulkUpdateApplicationsPayloadModeAtomic:
+		items, err = applyBulkAtomic(ctx, srvc, user.ID, ids, bulk.Change)
+		if err != nil {
+			repo.Rollback(ctx)
+
+			if status, _ := applicationErrorStatus(err); status == http.StatusInternalServerError {
+				WithInternalServerError(ctx, w, "")
+				return
+			}
+
+			// the report tells which application failed, nothing is changed
+			code = http.StatusConflict
+			break
+		}
+
+		err = repo.Commit()
+		if err != nil {
+			fmt.Println("cannot commit result: ", err)
+			WithInternalServerError(ctx, w, http.StatusText(http.StatusInternalServerError))
+			return
+		}
+	case specs.BulkUpdateApplicationsPayloadModePerItem:
+		// the plan is only read, every application is changed in its own transaction
+		repo.Rollback(ctx)
+		items = ctrl.applyBulkPerItem(ctx, user.ID, ids, bulk.Change)
+	default:
+		repo.Rollback(ctx)
+		WithBadRequestError(ctx, w, "invalid mode")
+		return
+	}
+
+	res := specs.BulkUpdateApplicationsResponse{
+		Mode:  specs.BulkUpdateApplicationsResponseMode(mode),
+		Items: items,
+	}
+
+	for _, item := range items {
+		switch item.Result {
+		case specs.BulkUpdateItemResultResultApplied:
+			res.Applied++
+		case specs.BulkUpdateItemResultResultFailed:
+			res.Failed++
+		}
+	}
+
+	withJSON(ctx, w, code, res)
+}
+
+// applyBulkAtomic changes the applications in the transaction of the service until the first failure.
+// On failure the changed applications are reported as rolled back, the rest as skipped,
+// and the returned error tells the caller to roll the transaction back.
+func applyBulkAtomic(ctx context.Context, srvc *service.Service, userID uuid.UUID,
+	ids []uuid.UUID, change service.BulkChange) ([]specs.BulkUpdateItemResult, error) {
+	items := make([]specs.BulkUpdateItemResult, len(ids))
+
+	for i, id := range ids {
+		err := srvc.ApplyBulkChange(ctx, userID, id, change)
+		if err != nil {
+			items[i] = bulkFailure(ctx, id, err)
+
+			for j := range ids[:i] {
+				items[j] = bulkItemResult(ids[j], specs.BulkUpdateItemResultResultRolledBack)
+			}
+
+			for j := i + 1; j < len(ids); j++ {
+				items[j] = bulkItemResult(ids[j], specs.BulkUpdateItemResultResultSkipped)
+			}
+
+			return items, err
+		}
+
+		items[i] = bulkItemResult(id, specs.BulkUpdateItemResultResultApplied)
+	}
+
+	return items, nil
+}
+
+// applyBulkPerItem changes every application in its own transaction, so a failure affects only its application.
+func (ctrl *Controller) applyBulkPerItem(ctx context.Context, userID uuid.UUID,
+	ids []uuid.UUID, change service.BulkChange) []specs.BulkUpdateItemResult {
+	items := make([]specs.BulkUpdateItemResult, 0, len(ids))
+
+	for _, id := range ids {
+		srvc, repo, err := ctrl.createTxService(ctx)
+		if err != nil {
+			items = append(items, bulkFailure(ctx, id, err))
+			continue
+		}
+
+		err = srvc.ApplyBulkChange(ctx, userID, id, change)
+		if err != nil {
+			repo.Rollback(ctx)
+			items = append(items, bulkFailure(ctx, id, err))
+			continue
+		}
+
+		err = repo.Commit()
+		if err != nil {
+			items = append(items, bulkFailure(ctx, id, err))
+			continue
+		}
+
+		items = append(items, bulkItemResult(id, specs.BulkUpdateItemResultResultApplied))
+	}
+
+	return items
+}
+
+func bulkItemResult(id uuid.UUID, result specs.BulkUpdateItemResultResult) specs.BulkUpdateItemResult {
+	return specs.BulkUpdateItemResult{
+		ApplicationId: id.String(),
+		Result:        result,
+	}
+}
+
+// bulkFailure reports the failure of the application with the same code and message as UpdateApplication.
+func bulkFailure(ctx context.Context, id uuid.UUID, err error) specs.BulkUpdateItemResult {
+	code, message := applicationErrorStatus(err)
+	if code == http.StatusInternalServerError {
+		zerolog.Ctx(ctx).Error().Err(err).Str("application_id", id.String()).Msg("bulk update application")
+		message = strings.ToLower(http.StatusText(code))
+	}
+
+	item := bulkItemResult(id, specs.BulkUpdateItemResultResultFailed)
+	item.Code = &code
+	item.Reason = &message
+
+	return item
+}
+
+// ApiToBulkUpdate converts the payload, the filter fields are parsed the same way as the list of applications.
+func ApiToBulkUpdate(in specs.BulkUpdateApplicationsPayload) (service.BulkUpdate, error) {
+	out := service.BulkUpdate{}
+
+	if in.Ids != nil {
+		ids, err := arrayInArrayWithError(*in.Ids, uuid.Parse)
+		if err != nil {
+			return out, errors.New("invalid ids")
+		}
+		out.IDs = ids
+	}
+
+	if in.Filter != nil {
+		filter, err := ApiToBulkFilter(*in.Filter)
+		if err != nil {
+			return out, err
+		}
+		out.Filter = filter
+	}
+
+	if in.Status != nil {
+		out.Change.Status = ApiToStatus(*in.Status)
+		if out.Change.Status == "" {
+			return out, errors.New("invalid status")
+		}
+	}
+
+	if in.Priority != nil {
+		out.Change.Priority = ApiToPriority(*in.Priority)
+		if out.Change.Priority == "" {
+			return out, errors.New("invalid priority")
+		}
+	}
+
+	if in.PerformerId != nil {
+		performerID, err := uuid.Parse(*in.PerformerId)
+		if err != nil {
+			return out, errors.New("invalid performer_id")
+		}
+		out.Change.PerformerID = &performerID
+	}
+
+	return out, nil
+}
+
+// ApiToBulkFilter parses the filter the same way as the list of applications.
+func ApiToBulkFilter(in specs.BulkApplicationFilter) (*service.ApplicationFilter, error) {
+	params := applicationFilterParams{
+		PerformerID:  in.PerformerId,
+		CreatorID:    in.CreatorId,
+		Type:         in.Type,
+		BuildingID:   in.BuildingId,
+		ApartmentID:  in.ApartmentId,
+		RecurrenceID: in.RecurrenceId,
+		Status:       in.Status,
+		Priority:     in.Priority,
+		Overdue:      in.Overdue,
+		Q:            in.Q,
+	}
+
+	if in.Fields != nil {
+		params.Fields = in.Fields.AdditionalProperties
+	}
+
+	filter, err := ApiToApplicationFilter(params)
+	if err != nil {
+		return nil, fmt.Errorf("filter: %w", err)
+	}
+
+	return &filter, nil
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"bio/service"
+	"bio/specs"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// withChecklistError maps errors of the checklist operations to responses.
+func withChecklistError(ctx context.Context, w http.ResponseWriter, name string, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		WithNotFoundError(ctx, w, "not found")
+	case errors.Is(err, service.ErrForbidden):
+		WithForbiddenError(ctx, w, "checklists are set by moderators and ticked off by the performer")
+	case errors.Is(err, service.ErrInvalidChecklist):
+		WithBadRequestError(ctx, w, "checklist item needs a title")
+	case errors.Is(err, service.ErrChecklistLocked):
+		WithStatusConflictError(ctx, w, err.Error())
+	default:
+		fmt.Println(name+": ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+}
+
+func (ctrl *Controller) GetSubTypeChecklist(w http.ResponseWriter, r *http.Request, subtypeId string) {
+	id, ok := parsePathID(r.Context(), w, "subtype", subtypeId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withChecklistError, "get subtype checklist",
+		func(ctx context.Context, srvc *service.Service, _ uuid.UUID) (interface{}, error) {
+			items, err := srvc.GetSubTypeChecklist(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return SubTypeChecklistToAPI(id, items), nil
+		})
+}
+
+func (ctrl *Controller) SetSubTypeChecklist(w http.ResponseWriter, r *http.Request, subtypeId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "subtype", subtypeId)
+	if !ok {
+		return
+	}
+
+	req := specs.SetSubTypeChecklistPayload{}
+	if !decodeBody(ctx, w, r, "checklist", &req) {
+		return
+	}
+
+	items := arrayInArray(req.Items, ApiToChecklistItem)
+
+	ctrl.handleEntityAction(w, r, withChecklistError, "set subtype checklist",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			saved, err := srvc.SetSubTypeChecklist(ctx, userID, id, items)
+			if err != nil {
+				return nil, err
+			}
+			return SubTypeChecklistToAPI(id, saved), nil
+		})
+}
+
+func (ctrl *Controller) ListApplicationChecklist(w http.ResponseWriter, r *http.Request, applicationId string) {
+	id, ok := parsePathID(r.Context(), w, "application", applicationId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withChecklistError, "list application checklist",
+		func(ctx context.Context, srvc *service.Service, _ uuid.UUID) (interface{}, error) {
+			items, err := srvc.ListApplicationChecklist(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return specs.ApplicationChecklistResponse{
+				ApplicationId: id.String(),
+				Items:         arrayInArray(items, ApplicationChecklistItemToAPI),
+			}, nil
+		})
+}
+
+func (ctrl *Controller) SetApplicationChecklistItem(w http.ResponseWriter, r *http.Request, applicationId string, itemId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "application", applicationId)
+	if !ok {
+		return
+	}
+
+	itemID, ok := parsePathID(ctx, w, "checklist item", itemId)
+	if !ok {
+		return
+	}
+
+	req := specs.SetApplicationChecklistItemPayload{}
+	if !decodeBody(ctx, w, r, "checklist item", &req) {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withChecklistError, "set application checklist item",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			item, err := srvc.SetApplicationChecklistItemDone(ctx, userID, id, itemID, req.Done)
+			if err != nil {
+				return nil, err
+			}
+			return ApplicationChecklistItemToAPI(*item), nil
+		})
+}
+
+func ApiToChecklistItem(in specs.ChecklistItem) service.ChecklistItem {
+	return service.ChecklistItem{
+		Title:    in.Title,
+		Required: in.Required,
+	}
+}
+
+func ChecklistItemToAPI(in service.ChecklistItem) specs.ChecklistItem {
+	return specs.ChecklistItem{
+		Title:    in.Title,
+		Required: in.Required,
+	}
+}
+
+func SubTypeChecklistToAPI(subTypeID uuid.UUID, items []service.ChecklistItem) specs.SubTypeChecklistResponse {
+	return specs.SubTypeChecklistResponse{
+		SubtypeId: subTypeID.String(),
+		Items:     arrayInArray(items, ChecklistItemToAPI),
+	}
+}
+
+func ApplicationChecklistItemToAPI(in service.ApplicationChecklistItem) specs.ApplicationChecklistItemResponse {
+	out := specs.ApplicationChecklistItemResponse{
+		Id:            in.ID.String(),
+		ApplicationId: in.ApplicationID.String(),
+		Title:         in.Title,
+		Required:      in.Required,
+		Done:          in.DoneAt != nil,
+		DoneAt:        in.DoneAt,
+	}
+
+	if in.DoneBy != nil {
+		out.DoneBy = toPoint(in.DoneBy.String())
+	}
+
+	return out
+}
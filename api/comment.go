@@ -0,0 +1,336 @@
+package api
+
+import (
+	"bio/auth"
+	"bio/pagination"
+	"bio/service"
+	"bio/specs"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func GetApplicationCommentPaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     100,
+		DefaultLimit: 50,
+		OrderByMappgin: map[string]string{
+			"created_at": "ac.created_at",
+		},
+	}
+}
+
+func (ctrl *Controller) ListApplicationComments(w http.ResponseWriter, r *http.Request, applicationId string, params specs.ListApplicationCommentsParams) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	id, err := uuid.Parse(applicationId)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse application id")
+		WithBadRequestError(ctx, w, "invalid application id")
+		return
+	}
+
+	pgnPolitics, err := GetApplicationCommentPaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.ApplicationCommentFilter{
+		ApplicationID: id,
+		Pagination:    pgnPolitics,
+	}
+
+	srvc, repo, err := ctrl.createTxService(ctx)
+	if err != nil {
+		fmt.Println("create tx: ", err)
+		WithInternalServerError(ctx, w, "")
+		return
+	}
+
+	comments, total, err := srvc.ListApplicationComments(ctx, user.ID, filter)
+	switch err {
+	case nil:
+		err = repo.Commit()
+		if err != nil {
+			fmt.Println("cannot commit result: ", err)
+			WithInternalServerError(ctx, w, http.StatusText(http.StatusInternalServerError))
+			return
+		}
+
+		res := specs.ListApplicationCommentsResponse{
+			Data: arrayInArray(comments, ApplicationCommentToAPI),
+			Meta: specs.ResponseMetaTotal{
+				Total: total,
+			},
+		}
+		WithStatusOK(ctx, w, res)
+	case service.ErrNotFound:
+		repo.Rollback(ctx)
+		WithNotFoundError(ctx, w, "application not found")
+	case service.ErrForbidden:
+		repo.Rollback(ctx)
+		WithForbiddenError(ctx, w, "comments are available to the creator, the performer and moderators")
+	default:
+		repo.Rollback(ctx)
+		fmt.Println("list application comments: ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+	return
+}
+
+func (ctrl *Controller) CreateApplicationComment(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	id, err := uuid.Parse(applicationId)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse application id")
+		WithBadRequestError(ctx, w, "invalid application id")
+		return
+	}
+
+	comment, err := ApiToCreationApplicationComment(ctx, r.Body)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	comment.ApplicationID = id
+
+	srvc, repo, err := ctrl.createTxService(ctx)
+	if err != nil {
+		fmt.Println("create tx: ", err)
+		WithInternalServerError(ctx, w, "")
+		return
+	}
+
+	createdComment, err := srvc.CreateApplicationComment(ctx, user.ID, *comment)
+	switch err {
+	case nil:
+		err = repo.Commit()
+		if err != nil {
+			fmt.Println("cannot commit result: ", err)
+			WithInternalServerError(ctx, w, http.StatusText(http.StatusInternalServerError))
+			return
+		}
+		res := ApplicationCommentToAPI(*createdComment)
+		WithStatusOK(ctx, w, res)
+	case service.ErrNotFound:
+		repo.Rollback(ctx)
+		WithNotFoundError(ctx, w, "application not found")
+	case service.ErrForbidden:
+		repo.Rollback(ctx)
+		WithForbiddenError(ctx, w, "comments are left by the creator, the performer and moderators, internal ones only by staff")
+	default:
+		repo.Rollback(ctx)
+		fmt.Println("create application comment: ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+	return
+}
+
+func ApiToCreationApplicationComment(ctx context.Context, body io.ReadCloser) (*service.ApplicationComment, error) {
+	entry := zerolog.Ctx(ctx)
+	reqComment := specs.CreateApplicationCommentPayload{}
+
+	err := json.NewDecoder(body).Decode(&reqComment)
+	if err != nil {
+		entry.Warn().Err(err).Msg("get comment json body")
+		return nil, errors.New("incorrect json")
+	}
+
+	if reqComment.Text == "" {
+		entry.Warn().Msg("empty Text")
+		return nil, errors.New("empty Text")
+	}
+
+	comment := &service.ApplicationComment{
+		ID:        uuid.New(),
+		CreatedAt: time.Now().UTC(),
+		Text:      reqComment.Text,
+	}
+
+	if reqComment.Internal != nil {
+		comment.Internal = *reqComment.Internal
+	}
+
+	return comment, nil
+}
+
+func (ctrl *Controller) UpdateApplicationComment(w http.ResponseWriter, r *http.Request, applicationId string, commentId string) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	applID, err := uuid.Parse(applicationId)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse application id")
+		WithBadRequestError(ctx, w, "invalid application id")
+		return
+	}
+
+	id, err := uuid.Parse(commentId)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse comment id")
+		WithBadRequestError(ctx, w, "invalid comment id")
+		return
+	}
+
+	update, err := ApiToUpdateApplicationComment(ctx, r.Body)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	update.ID = id
+	update.ApplicationID = applID
+
+	srvc, repo, err := ctrl.createTxService(ctx)
+	if err != nil {
+		fmt.Println("create tx: ", err)
+		WithInternalServerError(ctx, w, "")
+		return
+	}
+
+	comment, err := srvc.UpdateApplicationComment(ctx, user.ID, *update)
+	switch err {
+	case nil:
+		err = repo.Commit()
+		if err != nil {
+			fmt.Println("cannot commit result: ", err)
+			WithInternalServerError(ctx, w, http.StatusText(http.StatusInternalServerError))
+			return
+		}
+		res := ApplicationCommentToAPI(*comment)
+		WithStatusOK(ctx, w, res)
+	case service.ErrNotFound:
+		repo.Rollback(ctx)
+		WithNotFoundError(ctx, w, "comment not found")
+	case service.ErrForbidden:
+		repo.Rollback(ctx)
+		WithForbiddenError(ctx, w, "comment can not be edited")
+	default:
+		repo.Rollback(ctx)
+		fmt.Println("update application comment: ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+	return
+}
+
+func ApiToUpdateApplicationComment(ctx context.Context, body io.ReadCloser) (*service.ApplicationCommentUpdate, error) {
+	entry := zerolog.Ctx(ctx)
+	reqComment := specs.UpdateApplicationCommentPayload{}
+
+	err := json.NewDecoder(body).Decode(&reqComment)
+	if err != nil {
+		entry.Warn().Err(err).Msg("get comment json body")
+		return nil, errors.New("incorrect json")
+	}
+
+	if reqComment.Text != nil && *reqComment.Text == "" {
+		entry.Warn().Msg("empty Text")
+		return nil, errors.New("empty Text")
+	}
+
+	return &service.ApplicationCommentUpdate{
+		Text:     reqComment.Text,
+		Internal: reqComment.Internal,
+	}, nil
+}
+
+func (ctrl *Controller) DeleteApplicationComment(w http.ResponseWriter, r *http.Request, applicationId string, commentId string) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	applID, err := uuid.Parse(applicationId)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse application id")
+		WithBadRequestError(ctx, w, "invalid application id")
+		return
+	}
+
+	id, err := uuid.Parse(commentId)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse comment id")
+		WithBadRequestError(ctx, w, "invalid comment id")
+		return
+	}
+
+	srvc, repo, err := ctrl.createTxService(ctx)
+	if err != nil {
+		fmt.Println("create tx: ", err)
+		WithInternalServerError(ctx, w, "")
+		return
+	}
+
+	err = srvc.DeleteApplicationComment(ctx, user.ID, applID, id)
+	switch err {
+	case nil:
+		err = repo.Commit()
+		if err != nil {
+			fmt.Println("cannot commit result: ", err)
+			WithInternalServerError(ctx, w, http.StatusText(http.StatusInternalServerError))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case service.ErrNotFound:
+		repo.Rollback(ctx)
+		WithNotFoundError(ctx, w, "comment not found")
+	case service.ErrForbidden:
+		repo.Rollback(ctx)
+		WithForbiddenError(ctx, w, "comment can not be deleted")
+	default:
+		repo.Rollback(ctx)
+		fmt.Println("delete application comment: ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+	return
+}
+
+func ApplicationCommentToAPI(in service.ApplicationComment) specs.ApplicationCommentResponse {
+	return specs.ApplicationCommentResponse{
+		Id:            in.ID.String(),
+		ApplicationId: in.ApplicationID.String(),
+		AuthorId:      in.AuthorID.String(),
+		CreatedAt:     in.CreatedAt,
+		UpdatedAt:     in.UpdatedAt,
+		Text:          in.Text,
+		Internal:      in.Internal,
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bio/auth"
 	"bio/service"
 	"bio/specs"
 	"context"
@@ -10,6 +11,7 @@ import (
 
 	"bio/repository"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 )
 
@@ -41,6 +43,75 @@ func (c *Controller) createTxService(ctx context.Context) (*service.Service, *re
 	return srvc, repTx, nil
 }
 
+// entityAction runs the operation and returns the response body, nil body means an empty response.
+type entityAction func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error)
+
+// handleEntityAction runs the action of the authorized user in a transaction and writes the result.
+func (c *Controller) handleEntityAction(w http.ResponseWriter, r *http.Request,
+	withErr func(ctx context.Context, w http.ResponseWriter, name string, err error), name string, action entityAction) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	srvc, repo, err := c.createTxService(ctx)
+	if err != nil {
+		fmt.Println("create tx: ", err)
+		WithInternalServerError(ctx, w, "")
+		return
+	}
+
+	res, err := action(ctx, srvc, user.ID)
+	if err != nil {
+		repo.Rollback(ctx)
+		withErr(ctx, w, name, err)
+		return
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		fmt.Println("cannot commit result: ", err)
+		WithInternalServerError(ctx, w, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+
+	if res == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	WithStatusOK(ctx, w, res)
+}
+
+// parsePathID parses the id from the path and writes the bad request response on failure.
+func parsePathID(ctx context.Context, w http.ResponseWriter, name, value string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("parse " + name + " id")
+		WithBadRequestError(ctx, w, "invalid "+name+" id")
+		return uuid.UUID{}, false
+	}
+
+	return id, true
+}
+
+// decodeBody decodes the json body and writes the bad request response on failure.
+func decodeBody(ctx context.Context, w http.ResponseWriter, r *http.Request, name string, v interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get " + name + " json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return false
+	}
+
+	return true
+}
+
 func arrayInArrayWithError[T any, R any](array []T, transform func(T) (R, error)) ([]R, error) {
 	if len(array) == 0 {
 		return nil, nil
@@ -106,6 +177,10 @@ func WithUnauthorizedError(ctx context.Context, w http.ResponseWriter) {
 	WithError(ctx, w, http.StatusUnauthorized, "unauthorized")
 }
 
+func WithForbiddenError(ctx context.Context, w http.ResponseWriter, message string) {
+	WithError(ctx, w, http.StatusForbidden, message)
+}
+
 func WithStatusConflictError(ctx context.Context, w http.ResponseWriter, message string) {
 	WithError(ctx, w, http.StatusConflict, message)
 }
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"bio/service"
+	"bio/specs"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func (ctrl *Controller) MarkApplicationDuplicate(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctx := r.Context()
+
+	reqDuplicate := specs.MarkDuplicatePayload{}
+
+	err := json.NewDecoder(r.Body).Decode(&reqDuplicate)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get duplicate json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return
+	}
+
+	originalID, err := uuid.Parse(reqDuplicate.OriginalId)
+	if err != nil {
+		WithBadRequestError(ctx, w, "invalid original id")
+		return
+	}
+
+	ctrl.handleApplicationAction(w, r, applicationId, "mark duplicate",
+		func(ctx context.Context, srvc *service.Service, userID, id uuid.UUID) (*service.Application, error) {
+			return srvc.MarkDuplicate(ctx, userID, id, originalID)
+		})
+}
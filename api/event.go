@@ -0,0 +1,121 @@
+package api
+
+import (
+	"bio/auth"
+	"bio/pagination"
+	"bio/service"
+	"bio/specs"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func GetApplicationEventPaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     100,
+		DefaultLimit: 50,
+		OrderByMappgin: map[string]string{
+			"created_at": "ae.created_at",
+		},
+	}
+}
+
+func (ctrl *Controller) ListApplicationHistory(w http.ResponseWriter, r *http.Request, applicationId string, params specs.ListApplicationHistoryParams) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	id, err := uuid.Parse(applicationId)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse application id")
+		WithBadRequestError(ctx, w, "invalid application id")
+		return
+	}
+
+	pgnPolitics, err := GetApplicationEventPaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.ApplicationEventFilter{
+		ApplicationID: id,
+		Pagination:    pgnPolitics,
+	}
+
+	srvc, repo, err := ctrl.createTxService(ctx)
+	if err != nil {
+		fmt.Println("create tx: ", err)
+		WithInternalServerError(ctx, w, "")
+		return
+	}
+
+	events, total, err := srvc.ListApplicationEvents(ctx, user.ID, filter)
+	switch err {
+	case nil:
+		err = repo.Commit()
+		if err != nil {
+			fmt.Println("cannot commit result: ", err)
+			WithInternalServerError(ctx, w, http.StatusText(http.StatusInternalServerError))
+			return
+		}
+
+		res := specs.ListApplicationHistoryResponse{
+			Data: arrayInArray(events, ApplicationEventToAPI),
+			Meta: specs.ResponseMetaTotal{
+				Total: total,
+			},
+		}
+		WithStatusOK(ctx, w, res)
+	case service.ErrNotFound:
+		repo.Rollback(ctx)
+		WithNotFoundError(ctx, w, "application not found")
+	case service.ErrForbidden:
+		repo.Rollback(ctx)
+		WithForbiddenError(ctx, w, "history is available to the creator, the performer and moderators")
+	default:
+		repo.Rollback(ctx)
+		fmt.Println("list application history: ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+	return
+}
+
+func ApplicationEventToAPI(in service.ApplicationEvent) specs.ApplicationEvent {
+	if in.Field == service.ApplEventFieldStatus {
+		in.OldValue = eventStatusToAPI(in.OldValue)
+		in.NewValue = eventStatusToAPI(in.NewValue)
+	}
+
+	out := specs.ApplicationEvent{
+		Id:            in.ID.String(),
+		ApplicationId: in.ApplicationID.String(),
+		CreatedAt:     in.CreatedAt,
+		Field:         specs.ApplicationEventField(in.Field),
+		OldValue:      in.OldValue,
+		NewValue:      in.NewValue,
+		Comment:       in.Comment,
+	}
+
+	if in.AuthorID != nil {
+		out.AuthorId = toPoint(in.AuthorID.String())
+	}
+
+	return out
+}
+
+func eventStatusToAPI(in *string) *string {
+	if in == nil {
+		return nil
+	}
+
+	return toPoint(string(StatusToApi(service.ApplicationStatus(*in))))
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"bio/service"
+	"errors"
+)
+
+// ApiToLocation converts the optional pair of coordinates, both of them have to be set or not set.
+func ApiToLocation(latitude, longitude *float64) (*service.GeoPoint, error) {
+	if latitude == nil && longitude == nil {
+		return nil, nil
+	}
+
+	if latitude == nil || longitude == nil {
+		return nil, errors.New("latitude and longitude are set together")
+	}
+
+	point := &service.GeoPoint{Latitude: *latitude, Longitude: *longitude}
+	if !point.IsValid() {
+		return nil, errors.New("invalid coordinates")
+	}
+
+	return point, nil
+}
+
+// ApiToGeoBox converts the south-west latitude and longitude and the north-east latitude and longitude.
+func ApiToGeoBox(bbox []float64) (*service.GeoBox, error) {
+	if len(bbox) != 4 {
+		return nil, errors.New("bbox has to contain 4 coordinates")
+	}
+
+	box := &service.GeoBox{
+		SouthWest: service.GeoPoint{Latitude: bbox[0], Longitude: bbox[1]},
+		NorthEast: service.GeoPoint{Latitude: bbox[2], Longitude: bbox[3]},
+	}
+	if !box.IsValid() {
+		return nil, errors.New("invalid bbox")
+	}
+
+	return box, nil
+}
+
+func LocationToAPI(in *service.GeoPoint) (latitude, longitude *float64) {
+	if in == nil {
+		return nil, nil
+	}
+
+	return toPoint(in.Latitude), toPoint(in.Longitude)
+}
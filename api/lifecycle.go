@@ -0,0 +1,62 @@
+package api
+
+import (
+	"bio/service"
+	"bio/specs"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func (ctrl *Controller) CancelApplication(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctx := r.Context()
+
+	reason, err := decodeStatusChangeReason(r)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get cancel json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return
+	}
+
+	ctrl.handleApplicationAction(w, r, applicationId, "cancel application",
+		func(ctx context.Context, srvc *service.Service, userID, id uuid.UUID) (*service.Application, error) {
+			return srvc.CancelApplication(ctx, userID, id, reason)
+		})
+}
+
+func (ctrl *Controller) ReopenApplication(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctx := r.Context()
+
+	reason, err := decodeStatusChangeReason(r)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get reopen json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return
+	}
+
+	ctrl.handleApplicationAction(w, r, applicationId, "reopen application",
+		func(ctx context.Context, srvc *service.Service, userID, id uuid.UUID) (*service.Application, error) {
+			return srvc.ReopenApplication(ctx, userID, id, reason)
+		})
+}
+
+// decodeStatusChangeReason reads the optional body with the reason of the status change.
+func decodeStatusChangeReason(r *http.Request) (*string, error) {
+	req := specs.ChangeApplicationStatusPayload{}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	if req.Reason != nil && *req.Reason == "" {
+		return nil, nil
+	}
+
+	return req.Reason, nil
+}
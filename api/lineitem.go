@@ -0,0 +1,216 @@
+package api
+
+import (
+	"bio/pagination"
+	"bio/service"
+	"bio/specs"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func GetApplicationLineItemPaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     200,
+		DefaultLimit: 100,
+		OrderByMappgin: map[string]string{
+			"created_at": "li.created_at",
+		},
+	}
+}
+
+// withLineItemError maps errors of the line item operations to responses.
+func withLineItemError(ctx context.Context, w http.ResponseWriter, name string, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		WithNotFoundError(ctx, w, "not found")
+	case errors.Is(err, service.ErrForbidden):
+		WithForbiddenError(ctx, w, "line items are recorded by the performer")
+	case errors.Is(err, service.ErrInvalidLineItem):
+		WithBadRequestError(ctx, w, "line item needs a material or labour hours, amounts may not be negative")
+	case errors.Is(err, service.ErrLineItemsLocked):
+		WithStatusConflictError(ctx, w, err.Error())
+	default:
+		fmt.Println(name+": ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+}
+
+func (ctrl *Controller) ListApplicationLineItems(w http.ResponseWriter, r *http.Request, applicationId string, params specs.ListApplicationLineItemsParams) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "application", applicationId)
+	if !ok {
+		return
+	}
+
+	pgnPolitics, err := GetApplicationLineItemPaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.ApplicationLineItemFilter{
+		ApplicationID: id,
+		Pagination:    pgnPolitics,
+	}
+
+	ctrl.handleEntityAction(w, r, withLineItemError, "list application line items",
+		func(ctx context.Context, srvc *service.Service, _ uuid.UUID) (interface{}, error) {
+			items, total, err := srvc.ListApplicationLineItems(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			return specs.ListApplicationLineItemsResponse{
+				Data: arrayInArray(items, ApplicationLineItemToAPI),
+				Meta: specs.ResponseMetaTotal{
+					Total: total,
+				},
+			}, nil
+		})
+}
+
+func (ctrl *Controller) CreateApplicationLineItem(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "application", applicationId)
+	if !ok {
+		return
+	}
+
+	req := specs.CreateApplicationLineItemPayload{}
+	if !decodeBody(ctx, w, r, "line item", &req) {
+		return
+	}
+
+	now := time.Now().UTC()
+
+	item := service.ApplicationLineItem{
+		ID:            uuid.New(),
+		ApplicationID: id,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if req.Material != nil {
+		item.Material = *req.Material
+	}
+
+	if req.Quantity != nil {
+		item.Quantity = *req.Quantity
+	}
+
+	if req.UnitPrice != nil {
+		item.UnitPrice = *req.UnitPrice
+	}
+
+	if req.LabourHours != nil {
+		item.LabourHours = *req.LabourHours
+	}
+
+	ctrl.handleEntityAction(w, r, withLineItemError, "create application line item",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			created, err := srvc.CreateApplicationLineItem(ctx, userID, item)
+			if err != nil {
+				return nil, err
+			}
+			return ApplicationLineItemToAPI(*created), nil
+		})
+}
+
+func (ctrl *Controller) UpdateApplicationLineItem(w http.ResponseWriter, r *http.Request, applicationId string, itemId string) {
+	ctx := r.Context()
+
+	applID, ok := parsePathID(ctx, w, "application", applicationId)
+	if !ok {
+		return
+	}
+
+	id, ok := parsePathID(ctx, w, "line item", itemId)
+	if !ok {
+		return
+	}
+
+	req := specs.UpdateApplicationLineItemPayload{}
+	if !decodeBody(ctx, w, r, "line item", &req) {
+		return
+	}
+
+	update := service.ApplicationLineItemUpdate{
+		ID:            id,
+		ApplicationID: applID,
+		Material:      req.Material,
+		Quantity:      req.Quantity,
+		UnitPrice:     req.UnitPrice,
+		LabourHours:   req.LabourHours,
+	}
+
+	ctrl.handleEntityAction(w, r, withLineItemError, "update application line item",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			item, err := srvc.UpdateApplicationLineItem(ctx, userID, update)
+			if err != nil {
+				return nil, err
+			}
+			return ApplicationLineItemToAPI(*item), nil
+		})
+}
+
+func (ctrl *Controller) DeleteApplicationLineItem(w http.ResponseWriter, r *http.Request, applicationId string, itemId string) {
+	ctx := r.Context()
+
+	applID, ok := parsePathID(ctx, w, "application", applicationId)
+	if !ok {
+		return
+	}
+
+	id, ok := parsePathID(ctx, w, "line item", itemId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withLineItemError, "delete application line item",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			return nil, srvc.DeleteApplicationLineItem(ctx, userID, applID, id)
+		})
+}
+
+func (ctrl *Controller) GetApplicationCosts(w http.ResponseWriter, r *http.Request) {
+	ctrl.handleEntityAction(w, r, withLineItemError, "get application costs",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			stats, err := srvc.GetCostReport(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			return specs.CostReportResponse{
+				Types: arrayInArray(stats, CostStatToAPI),
+			}, nil
+		})
+}
+
+func ApplicationLineItemToAPI(in service.ApplicationLineItem) specs.ApplicationLineItemResponse {
+	return specs.ApplicationLineItemResponse{
+		Id:            in.ID.String(),
+		ApplicationId: in.ApplicationID.String(),
+		AuthorId:      in.AuthorID.String(),
+		CreatedAt:     in.CreatedAt,
+		UpdatedAt:     in.UpdatedAt,
+		Material:      in.Material,
+		Quantity:      in.Quantity,
+		UnitPrice:     in.UnitPrice,
+		LabourHours:   in.LabourHours,
+		Cost:          in.Cost(),
+	}
+}
+
+func CostStatToAPI(in service.CostStat) specs.CostStat {
+	return specs.CostStat{
+		Id:            in.ID.String(),
+		Count:         in.Count,
+		MaterialsCost: in.MaterialsCost,
+		LabourHours:   in.LabourHours,
+	}
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// parseAcceptLanguage returns the locales of the Accept-Language header from the most preferred one.
+// Locales with zero quality and the wildcard are skipped, the default title stands for them.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale  string
+		quality float64
+	}
+
+	var ranges []weighted
+
+	for _, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+
+		locale := strings.ToLower(strings.TrimSpace(params[0]))
+		if locale == "" || locale == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+
+			q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+			if err != nil {
+				q = 0
+			}
+			quality = q
+		}
+
+		if quality <= 0 {
+			continue
+		}
+
+		ranges = append(ranges, weighted{locale: locale, quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	locales := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		locales = append(locales, r.locale)
+	}
+
+	return locales
+}
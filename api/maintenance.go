@@ -0,0 +1,351 @@
+package api
+
+import (
+	"bio/pagination"
+	"bio/service"
+	"bio/specs"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func GetMaintenanceTemplatePaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     200,
+		DefaultLimit: 50,
+		OrderByMappgin: map[string]string{
+			"date_created": "mt.created_at",
+			"next_run_at":  "mt.next_run_at",
+		},
+	}
+}
+
+// withMaintenanceError maps errors of the maintenance template operations to responses.
+func withMaintenanceError(ctx context.Context, w http.ResponseWriter, name string, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		WithNotFoundError(ctx, w, "not found")
+	case errors.Is(err, service.ErrForbidden):
+		WithForbiddenError(ctx, w, "maintenance is planned by moderators")
+	case errors.Is(err, service.ErrInvalidTemplate):
+		WithBadRequestError(ctx, w, "invalid schedule, subtype or performer")
+	case errors.Is(err, service.ErrInvalidPremises):
+		WithBadRequestError(ctx, w, "invalid premises")
+	default:
+		fmt.Println(name+": ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+}
+
+// parseOptionalID parses the optional id from the request and writes the bad request response on failure.
+func parseOptionalID(ctx context.Context, w http.ResponseWriter, name string, value *string) (*uuid.UUID, bool) {
+	if value == nil {
+		return nil, true
+	}
+
+	id, ok := parsePathID(ctx, w, name, *value)
+	if !ok {
+		return nil, false
+	}
+
+	return &id, true
+}
+
+func (ctrl *Controller) CreateMaintenanceTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := specs.CreateMaintenanceTemplatePayload{}
+	if !decodeBody(ctx, w, r, "maintenance template", &req) {
+		return
+	}
+
+	tmpl := service.MaintenanceTemplate{
+		ID:        uuid.New(),
+		CreatedAt: time.Now().UTC(),
+		Text:      req.Text,
+		Schedule:  req.Schedule,
+		Active:    true,
+	}
+
+	ids := []struct {
+		name  string
+		value string
+		dst   *uuid.UUID
+	}{
+		{"type", req.Type, &tmpl.Type},
+		{"subtype", req.Subtype, &tmpl.SubType},
+		{"building", req.BuildingId, &tmpl.BuildingID},
+	}
+
+	for _, id := range ids {
+		parsed, ok := parsePathID(ctx, w, id.name, id.value)
+		if !ok {
+			return
+		}
+		*id.dst = parsed
+	}
+
+	var ok bool
+
+	tmpl.ApartmentID, ok = parseOptionalID(ctx, w, "apartment", req.ApartmentId)
+	if !ok {
+		return
+	}
+
+	tmpl.PerformerID, ok = parseOptionalID(ctx, w, "performer", req.PerformerId)
+	if !ok {
+		return
+	}
+
+	if req.Priority != nil {
+		tmpl.Priority = ApiToPriority(*req.Priority)
+		if tmpl.Priority == "" {
+			WithBadRequestError(ctx, w, "invalid priority")
+			return
+		}
+	}
+
+	ctrl.handleEntityAction(w, r, withMaintenanceError, "create maintenance template",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			created, err := srvc.CreateMaintenanceTemplate(ctx, userID, tmpl)
+			if err != nil {
+				return nil, err
+			}
+			return MaintenanceTemplateToAPI(*created), nil
+		})
+}
+
+func (ctrl *Controller) GetMaintenanceTemplate(w http.ResponseWriter, r *http.Request, templateId string) {
+	id, ok := parsePathID(r.Context(), w, "maintenance template", templateId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withMaintenanceError, "get maintenance template",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			tmpl, err := srvc.GetMaintenanceTemplate(ctx, userID, id)
+			if err != nil {
+				return nil, err
+			}
+			return MaintenanceTemplateToAPI(*tmpl), nil
+		})
+}
+
+func (ctrl *Controller) ListMaintenanceTemplates(w http.ResponseWriter, r *http.Request, params specs.ListMaintenanceTemplatesParams) {
+	ctx := r.Context()
+
+	pgnPolitics, err := GetMaintenanceTemplatePaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.MaintenanceTemplateFilter{
+		Active:     params.Active,
+		Pagination: pgnPolitics,
+	}
+
+	var ok bool
+
+	filter.BuildingID, ok = parseOptionalID(ctx, w, "building", params.BuildingId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withMaintenanceError, "list maintenance templates",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			templates, total, err := srvc.ListMaintenanceTemplates(ctx, userID, filter)
+			if err != nil {
+				return nil, err
+			}
+			return specs.ListMaintenanceTemplatesResponse{
+				Data: arrayInArray(templates, MaintenanceTemplateToAPI),
+				Meta: specs.ResponseMetaTotal{
+					Total: total,
+				},
+			}, nil
+		})
+}
+
+func (ctrl *Controller) UpdateMaintenanceTemplate(w http.ResponseWriter, r *http.Request, templateId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "maintenance template", templateId)
+	if !ok {
+		return
+	}
+
+	req := specs.UpdateMaintenanceTemplatePayload{}
+	if !decodeBody(ctx, w, r, "maintenance template", &req) {
+		return
+	}
+
+	update := service.MaintenanceTemplateUpdate{
+		ID:       id,
+		Text:     req.Text,
+		Schedule: req.Schedule,
+		Active:   req.Active,
+	}
+
+	update.PerformerID, ok = parseOptionalID(ctx, w, "performer", req.PerformerId)
+	if !ok {
+		return
+	}
+
+	if req.Priority != nil {
+		priority := ApiToPriority(*req.Priority)
+		if priority == "" {
+			WithBadRequestError(ctx, w, "invalid priority")
+			return
+		}
+		update.Priority = &priority
+	}
+
+	ctrl.handleEntityAction(w, r, withMaintenanceError, "update maintenance template",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			tmpl, err := srvc.UpdateMaintenanceTemplate(ctx, userID, update)
+			if err != nil {
+				return nil, err
+			}
+			return MaintenanceTemplateToAPI(*tmpl), nil
+		})
+}
+
+func (ctrl *Controller) DeleteMaintenanceTemplate(w http.ResponseWriter, r *http.Request, templateId string) {
+	id, ok := parsePathID(r.Context(), w, "maintenance template", templateId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withMaintenanceError, "delete maintenance template",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			return nil, srvc.DeleteMaintenanceTemplate(ctx, userID, id)
+		})
+}
+
+// DefaultMaintenanceInterval is the period of RunMaintenanceScheduler, the schedules of templates have minute precision.
+const DefaultMaintenanceInterval = time.Minute
+
+// RunMaintenanceScheduler creates the applications of due maintenance templates every interval
+// until the context is done. Every template is handled in its own transaction, so several
+// instances of the scheduler may run at once. The server starts it next to the HTTP handler:
+//
+//	go ctrl.RunMaintenanceScheduler(ctx, api.DefaultMaintenanceInterval)
+func (c *Controller) RunMaintenanceScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.runDueMaintenance(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runDueMaintenance runs the due templates one by one. A failed template is recorded and
+// skipped till its next run, so it does not hold up the rest.
+func (c *Controller) runDueMaintenance(ctx context.Context) {
+	logger := zerolog.Ctx(ctx)
+
+	for ctx.Err() == nil {
+		srvc, repo, err := c.createTxService(ctx)
+		if err != nil {
+			logger.Error().Err(err).Msg("create tx for maintenance")
+			return
+		}
+
+		now := time.Now().UTC()
+
+		ran, err := srvc.RunDueMaintenance(ctx, now)
+		if err != nil {
+			repo.Rollback(ctx)
+
+			var runErr *service.MaintenanceRunError
+			if !errors.As(err, &runErr) {
+				logger.Error().Err(err).Msg("run due maintenance")
+				return
+			}
+
+			logger.Error().Err(runErr.Err).Str("template_id", runErr.TemplateID.String()).Msg("run maintenance template")
+
+			if !c.recordMaintenanceFailure(ctx, runErr, now) {
+				return
+			}
+
+			continue
+		}
+
+		err = repo.Commit()
+		if err != nil {
+			logger.Error().Err(err).Msg("commit due maintenance")
+			return
+		}
+
+		if !ran {
+			return
+		}
+	}
+}
+
+// recordMaintenanceFailure stores the failure of the template in a new transaction and reports whether it succeeded.
+func (c *Controller) recordMaintenanceFailure(ctx context.Context, runErr *service.MaintenanceRunError, now time.Time) bool {
+	logger := zerolog.Ctx(ctx)
+
+	srvc, repo, err := c.createTxService(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("create tx for maintenance failure")
+		return false
+	}
+
+	err = srvc.RecordMaintenanceFailure(ctx, runErr.TemplateID, now, runErr.Err)
+	if err != nil {
+		repo.Rollback(ctx)
+		logger.Error().Err(err).Str("template_id", runErr.TemplateID.String()).Msg("record maintenance failure")
+		return false
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("commit maintenance failure")
+		return false
+	}
+
+	return true
+}
+
+func MaintenanceTemplateToAPI(in service.MaintenanceTemplate) specs.MaintenanceTemplateResponse {
+	out := specs.MaintenanceTemplateResponse{
+		Id:         in.ID.String(),
+		CreatedAt:  in.CreatedAt,
+		CreatorId:  in.CreatorID.String(),
+		Type:       in.Type.String(),
+		Subtype:    in.SubType.String(),
+		BuildingId: in.BuildingID.String(),
+		Text:       in.Text,
+		Priority:   specs.ApplicationPriority(in.Priority),
+		Schedule:   in.Schedule,
+		Active:     in.Active,
+		NextRunAt:  in.NextRunAt,
+		LastRunAt:  in.LastRunAt,
+		LastError:  in.LastError,
+	}
+
+	if in.ApartmentID != nil {
+		out.ApartmentId = toPoint(in.ApartmentID.String())
+	}
+
+	if in.PerformerID != nil {
+		out.PerformerId = toPoint(in.PerformerID.String())
+	}
+
+	return out
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"bio/auth"
+	"bio/service"
+	"bio/specs"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	photoFormField = "file"
+	// multipartOverhead is a reserve for multipart headers and boundaries.
+	multipartOverhead = 1 << 20
+
+	photoCacheControl = "public, max-age=31536000, immutable"
+)
+
+func (ctrl *Controller) UploadPhoto(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	if r.ContentLength > service.MaxPhotoSize+multipartOverhead {
+		WithError(ctx, w, http.StatusRequestEntityTooLarge, service.ErrPhotoTooLarge.Error())
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, service.MaxPhotoSize+multipartOverhead)
+
+	file, _, err := r.FormFile(photoFormField)
+	if err != nil {
+		logger.Warn().Err(err).Msg("get photo from multipart form")
+		WithBadRequestError(ctx, w, "invalid multipart form")
+		return
+	}
+	defer file.Close()
+
+	srvc, repo, err := ctrl.createTxService(ctx)
+	if err != nil {
+		fmt.Println("create tx: ", err)
+		WithInternalServerError(ctx, w, "")
+		return
+	}
+
+	photo, err := srvc.UploadPhoto(ctx, user.ID, file)
+	switch err {
+	case nil:
+		err = repo.Commit()
+		if err != nil {
+			// no row points at the stored files
+			srvc.DiscardPhotoFiles(ctx, photo.ID)
+			fmt.Println("cannot commit result: ", err)
+			WithInternalServerError(ctx, w, http.StatusText(http.StatusInternalServerError))
+			return
+		}
+		res := PhotoToAPI(photo)
+		WithStatusOK(ctx, w, res)
+	case service.ErrPhotoTooLarge:
+		repo.Rollback(ctx)
+		WithError(ctx, w, http.StatusRequestEntityTooLarge, err.Error())
+	case service.ErrPhotoContentType:
+		repo.Rollback(ctx)
+		WithBadRequestError(ctx, w, err.Error())
+	default:
+		repo.Rollback(ctx)
+		fmt.Println("upload photo: ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+	return
+}
+
+func PhotoToAPI(in *service.Photo) specs.PhotoResponse {
+	return specs.PhotoResponse{
+		Id:          in.ID.String(),
+		CreatedAt:   in.CreatedAt,
+		CreatorId:   in.CreatorID.String(),
+		ContentType: in.ContentType,
+		Size:        in.Size,
+	}
+}
+
+func ApiToPhotoSize(in specs.PhotoSize) service.PhotoSize {
+	return map[specs.PhotoSize]service.PhotoSize{
+		specs.PhotoSizeOriginal:  service.PhotoSizeOriginal,
+		specs.PhotoSizeThumbnail: service.PhotoSizeThumbnail,
+	}[in]
+}
+
+func (ctrl *Controller) GetPhoto(w http.ResponseWriter, r *http.Request, photoId string, params specs.GetPhotoParams) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	id, err := uuid.Parse(photoId)
+	if err != nil {
+		logger.Warn().Err(err).Msg("parse photo id")
+		WithBadRequestError(ctx, w, "invalid photo id")
+		return
+	}
+
+	size := service.PhotoSizeOriginal
+	if params.Size != nil {
+		size = ApiToPhotoSize(*params.Size)
+		if size == "" {
+			WithBadRequestError(ctx, w, "invalid size")
+			return
+		}
+	}
+
+	// photos are never changed, so the pair of id and size identifies the content
+	etag := fmt.Sprintf(`"%s-%s"`, id, size)
+
+	if r.Header.Get("If-None-Match") == etag {
+		// the etag is built from the request alone, so the photo may not exist
+		_, err = ctrl.srvc.GetPhoto(ctx, id)
+		switch err {
+		case nil:
+		case service.ErrNotFound:
+			WithNotFoundError(ctx, w, "photo not found")
+			return
+		default:
+			logger.Error().Err(err).Msg("get photo")
+			WithInternalServerError(ctx, w, "")
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", photoCacheControl)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	file, err := ctrl.srvc.GetPhotoFile(ctx, id, size)
+	switch err {
+	case nil:
+		defer file.Body.Close()
+
+		w.Header().Set("Content-Type", file.ContentType)
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", photoCacheControl)
+		w.Header().Set("Last-Modified", file.Photo.CreatedAt.UTC().Format(http.TimeFormat))
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, file.Body)
+		if err != nil {
+			logger.Error().Err(err).Msg("write photo")
+		}
+	case service.ErrNotFound:
+		WithNotFoundError(ctx, w, "photo not found")
+	default:
+		logger.Error().Err(err).Msg("get photo")
+		WithInternalServerError(ctx, w, "")
+	}
+	return
+}
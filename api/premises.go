@@ -0,0 +1,487 @@
+package api
+
+import (
+	"bio/pagination"
+	"bio/service"
+	"bio/specs"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func GetBuildingPaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     100,
+		DefaultLimit: 50,
+		OrderByMappgin: map[string]string{
+			"address":    "b.address",
+			"created_at": "b.created_at",
+		},
+	}
+}
+
+func GetEntrancePaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     100,
+		DefaultLimit: 50,
+		OrderByMappgin: map[string]string{
+			"number": "e.number",
+		},
+	}
+}
+
+func GetApartmentPaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     500,
+		DefaultLimit: 100,
+		OrderByMappgin: map[string]string{
+			"number": "ap.number",
+			"floor":  "ap.floor",
+		},
+	}
+}
+
+// withPremisesError maps errors of the premises operations to responses.
+func withPremisesError(ctx context.Context, w http.ResponseWriter, name string, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		WithNotFoundError(ctx, w, "not found")
+	case errors.Is(err, service.ErrForbidden):
+		WithForbiddenError(ctx, w, "premises are managed by moderators")
+	case errors.Is(err, service.ErrInvalidPremises):
+		WithBadRequestError(ctx, w, "entrance is not in the building")
+	default:
+		fmt.Println(name+": ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+}
+
+func (ctrl *Controller) CreateBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := specs.CreateBuildingPayload{}
+	if !decodeBody(ctx, w, r, "building", &req) {
+		return
+	}
+
+	if req.Address == "" {
+		WithBadRequestError(ctx, w, "empty address")
+		return
+	}
+
+	location, err := ApiToLocation(req.Latitude, req.Longitude)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	building := service.Building{
+		ID:        uuid.New(),
+		CreatedAt: time.Now().UTC(),
+		Address:   req.Address,
+		Location:  location,
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "create building",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			created, err := srvc.CreateBuilding(ctx, userID, building)
+			if err != nil {
+				return nil, err
+			}
+			return BuildingToAPI(*created), nil
+		})
+}
+
+func (ctrl *Controller) GetBuilding(w http.ResponseWriter, r *http.Request, buildingId string) {
+	id, ok := parsePathID(r.Context(), w, "building", buildingId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "get building",
+		func(ctx context.Context, srvc *service.Service, _ uuid.UUID) (interface{}, error) {
+			building, err := srvc.GetBuilding(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return BuildingToAPI(*building), nil
+		})
+}
+
+func (ctrl *Controller) ListBuildings(w http.ResponseWriter, r *http.Request, params specs.ListBuildingsParams) {
+	ctx := r.Context()
+
+	pgnPolitics, err := GetBuildingPaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.BuildingFilter{Pagination: pgnPolitics}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "list buildings",
+		func(ctx context.Context, srvc *service.Service, _ uuid.UUID) (interface{}, error) {
+			buildings, total, err := srvc.ListBuildings(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			return specs.ListBuildingsResponse{
+				Data: arrayInArray(buildings, BuildingToAPI),
+				Meta: specs.ResponseMetaTotal{
+					Total: total,
+				},
+			}, nil
+		})
+}
+
+func (ctrl *Controller) UpdateBuilding(w http.ResponseWriter, r *http.Request, buildingId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "building", buildingId)
+	if !ok {
+		return
+	}
+
+	req := specs.UpdateBuildingPayload{}
+	if !decodeBody(ctx, w, r, "building", &req) {
+		return
+	}
+
+	if req.Address != nil && *req.Address == "" {
+		WithBadRequestError(ctx, w, "empty address")
+		return
+	}
+
+	location, err := ApiToLocation(req.Latitude, req.Longitude)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	update := service.BuildingUpdate{ID: id, Address: req.Address, Location: location}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "update building",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			building, err := srvc.UpdateBuilding(ctx, userID, update)
+			if err != nil {
+				return nil, err
+			}
+			return BuildingToAPI(*building), nil
+		})
+}
+
+func (ctrl *Controller) DeleteBuilding(w http.ResponseWriter, r *http.Request, buildingId string) {
+	id, ok := parsePathID(r.Context(), w, "building", buildingId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "delete building",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			return nil, srvc.DeleteBuilding(ctx, userID, id)
+		})
+}
+
+func (ctrl *Controller) CreateEntrance(w http.ResponseWriter, r *http.Request, buildingId string) {
+	ctx := r.Context()
+
+	buildingID, ok := parsePathID(ctx, w, "building", buildingId)
+	if !ok {
+		return
+	}
+
+	req := specs.CreateEntrancePayload{}
+	if !decodeBody(ctx, w, r, "entrance", &req) {
+		return
+	}
+
+	if req.Number == "" {
+		WithBadRequestError(ctx, w, "empty number")
+		return
+	}
+
+	entrance := service.Entrance{
+		ID:         uuid.New(),
+		BuildingID: buildingID,
+		Number:     req.Number,
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "create entrance",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			created, err := srvc.CreateEntrance(ctx, userID, entrance)
+			if err != nil {
+				return nil, err
+			}
+			return EntranceToAPI(*created), nil
+		})
+}
+
+func (ctrl *Controller) ListEntrances(w http.ResponseWriter, r *http.Request, buildingId string, params specs.ListEntrancesParams) {
+	ctx := r.Context()
+
+	buildingID, ok := parsePathID(ctx, w, "building", buildingId)
+	if !ok {
+		return
+	}
+
+	pgnPolitics, err := GetEntrancePaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.EntranceFilter{
+		BuildingID: buildingID,
+		Pagination: pgnPolitics,
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "list entrances",
+		func(ctx context.Context, srvc *service.Service, _ uuid.UUID) (interface{}, error) {
+			entrances, total, err := srvc.ListEntrances(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			return specs.ListEntrancesResponse{
+				Data: arrayInArray(entrances, EntranceToAPI),
+				Meta: specs.ResponseMetaTotal{
+					Total: total,
+				},
+			}, nil
+		})
+}
+
+func (ctrl *Controller) UpdateEntrance(w http.ResponseWriter, r *http.Request, entranceId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "entrance", entranceId)
+	if !ok {
+		return
+	}
+
+	req := specs.UpdateEntrancePayload{}
+	if !decodeBody(ctx, w, r, "entrance", &req) {
+		return
+	}
+
+	if req.Number != nil && *req.Number == "" {
+		WithBadRequestError(ctx, w, "empty number")
+		return
+	}
+
+	update := service.EntranceUpdate{ID: id, Number: req.Number}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "update entrance",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			entrance, err := srvc.UpdateEntrance(ctx, userID, update)
+			if err != nil {
+				return nil, err
+			}
+			return EntranceToAPI(*entrance), nil
+		})
+}
+
+func (ctrl *Controller) DeleteEntrance(w http.ResponseWriter, r *http.Request, entranceId string) {
+	id, ok := parsePathID(r.Context(), w, "entrance", entranceId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "delete entrance",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			return nil, srvc.DeleteEntrance(ctx, userID, id)
+		})
+}
+
+func (ctrl *Controller) CreateApartment(w http.ResponseWriter, r *http.Request, buildingId string) {
+	ctx := r.Context()
+
+	buildingID, ok := parsePathID(ctx, w, "building", buildingId)
+	if !ok {
+		return
+	}
+
+	req := specs.CreateApartmentPayload{}
+	if !decodeBody(ctx, w, r, "apartment", &req) {
+		return
+	}
+
+	if req.Number == "" {
+		WithBadRequestError(ctx, w, "empty number")
+		return
+	}
+
+	apartment := service.Apartment{
+		ID:         uuid.New(),
+		BuildingID: buildingID,
+		Number:     req.Number,
+		Floor:      req.Floor,
+	}
+
+	if req.EntranceId != nil {
+		entranceID, ok := parsePathID(ctx, w, "entrance", *req.EntranceId)
+		if !ok {
+			return
+		}
+		apartment.EntranceID = &entranceID
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "create apartment",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			created, err := srvc.CreateApartment(ctx, userID, apartment)
+			if err != nil {
+				return nil, err
+			}
+			return ApartmentToAPI(*created), nil
+		})
+}
+
+func (ctrl *Controller) GetApartment(w http.ResponseWriter, r *http.Request, apartmentId string) {
+	id, ok := parsePathID(r.Context(), w, "apartment", apartmentId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "get apartment",
+		func(ctx context.Context, srvc *service.Service, _ uuid.UUID) (interface{}, error) {
+			apartment, err := srvc.GetApartment(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return ApartmentToAPI(*apartment), nil
+		})
+}
+
+func (ctrl *Controller) ListApartments(w http.ResponseWriter, r *http.Request, buildingId string, params specs.ListApartmentsParams) {
+	ctx := r.Context()
+
+	buildingID, ok := parsePathID(ctx, w, "building", buildingId)
+	if !ok {
+		return
+	}
+
+	pgnPolitics, err := GetApartmentPaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.ApartmentFilter{
+		BuildingID: buildingID,
+		Pagination: pgnPolitics,
+	}
+
+	if params.EntranceId != nil {
+		entranceID, ok := parsePathID(ctx, w, "entrance", *params.EntranceId)
+		if !ok {
+			return
+		}
+		filter.EntranceID = &entranceID
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "list apartments",
+		func(ctx context.Context, srvc *service.Service, _ uuid.UUID) (interface{}, error) {
+			apartments, total, err := srvc.ListApartments(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			return specs.ListApartmentsResponse{
+				Data: arrayInArray(apartments, ApartmentToAPI),
+				Meta: specs.ResponseMetaTotal{
+					Total: total,
+				},
+			}, nil
+		})
+}
+
+func (ctrl *Controller) UpdateApartment(w http.ResponseWriter, r *http.Request, apartmentId string) {
+	ctx := r.Context()
+
+	id, ok := parsePathID(ctx, w, "apartment", apartmentId)
+	if !ok {
+		return
+	}
+
+	req := specs.UpdateApartmentPayload{}
+	if !decodeBody(ctx, w, r, "apartment", &req) {
+		return
+	}
+
+	if req.Number != nil && *req.Number == "" {
+		WithBadRequestError(ctx, w, "empty number")
+		return
+	}
+
+	update := service.ApartmentUpdate{
+		ID:     id,
+		Number: req.Number,
+		Floor:  req.Floor,
+	}
+
+	if req.EntranceId != nil {
+		entranceID, ok := parsePathID(ctx, w, "entrance", *req.EntranceId)
+		if !ok {
+			return
+		}
+		update.EntranceID = &entranceID
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "update apartment",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			apartment, err := srvc.UpdateApartment(ctx, userID, update)
+			if err != nil {
+				return nil, err
+			}
+			return ApartmentToAPI(*apartment), nil
+		})
+}
+
+func (ctrl *Controller) DeleteApartment(w http.ResponseWriter, r *http.Request, apartmentId string) {
+	id, ok := parsePathID(r.Context(), w, "apartment", apartmentId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withPremisesError, "delete apartment",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			return nil, srvc.DeleteApartment(ctx, userID, id)
+		})
+}
+
+func BuildingToAPI(in service.Building) specs.BuildingResponse {
+	out := specs.BuildingResponse{
+		Id:        in.ID.String(),
+		CreatedAt: in.CreatedAt,
+		Address:   in.Address,
+	}
+
+	out.Latitude, out.Longitude = LocationToAPI(in.Location)
+
+	return out
+}
+
+func EntranceToAPI(in service.Entrance) specs.EntranceResponse {
+	return specs.EntranceResponse{
+		Id:         in.ID.String(),
+		BuildingId: in.BuildingID.String(),
+		Number:     in.Number,
+	}
+}
+
+func ApartmentToAPI(in service.Apartment) specs.ApartmentResponse {
+	out := specs.ApartmentResponse{
+		Id:         in.ID.String(),
+		BuildingId: in.BuildingID.String(),
+		Number:     in.Number,
+		Floor:      in.Floor,
+	}
+
+	if in.EntranceID != nil {
+		out.EntranceId = toPoint(in.EntranceID.String())
+	}
+
+	return out
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"bio/service"
+	"bio/specs"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// withQualificationError maps errors of the qualification operations to responses.
+func withQualificationError(ctx context.Context, w http.ResponseWriter, name string, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		WithNotFoundError(ctx, w, "not found")
+	case errors.Is(err, service.ErrForbidden):
+		WithForbiddenError(ctx, w, "qualifications are managed by moderators")
+	case errors.Is(err, service.ErrInvalidQualification):
+		WithBadRequestError(ctx, w, "qualifications are kept for workers, every subtype has to belong to its type and be listed once")
+	default:
+		fmt.Println(name+": ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+}
+
+func (ctrl *Controller) GetWorkerQualifications(w http.ResponseWriter, r *http.Request, userId string) {
+	workerID, ok := parsePathID(r.Context(), w, "user", userId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withQualificationError, "get worker qualifications",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			qualifications, err := srvc.GetWorkerQualifications(ctx, userID, workerID)
+			if err != nil {
+				return nil, err
+			}
+			return WorkerQualificationsToAPI(workerID, qualifications), nil
+		})
+}
+
+func (ctrl *Controller) SetWorkerQualifications(w http.ResponseWriter, r *http.Request, userId string) {
+	ctx := r.Context()
+
+	workerID, ok := parsePathID(ctx, w, "user", userId)
+	if !ok {
+		return
+	}
+
+	req := specs.SetWorkerQualificationsPayload{}
+	if !decodeBody(ctx, w, r, "qualifications", &req) {
+		return
+	}
+
+	qualifications, err := arrayInArrayWithError(req.Qualifications, ApiToWorkerQualification)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withQualificationError, "set worker qualifications",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			saved, err := srvc.SetWorkerQualifications(ctx, userID, workerID, qualifications)
+			if err != nil {
+				return nil, err
+			}
+			return WorkerQualificationsToAPI(workerID, saved), nil
+		})
+}
+
+func ApiToWorkerQualification(in specs.WorkerQualification) (service.WorkerQualification, error) {
+	out := service.WorkerQualification{}
+
+	typeID, err := uuid.Parse(in.TypeId)
+	if err != nil {
+		return out, errors.New("invalid type_id")
+	}
+	out.TypeID = typeID
+
+	if in.SubtypeId != nil {
+		subTypeID, err := uuid.Parse(*in.SubtypeId)
+		if err != nil {
+			return out, errors.New("invalid subtype_id")
+		}
+		out.SubTypeID = &subTypeID
+	}
+
+	return out, nil
+}
+
+func WorkerQualificationToAPI(in service.WorkerQualification) specs.WorkerQualification {
+	out := specs.WorkerQualification{
+		TypeId: in.TypeID.String(),
+	}
+
+	if in.SubTypeID != nil {
+		out.SubtypeId = toPoint(in.SubTypeID.String())
+	}
+
+	return out
+}
+
+func WorkerQualificationsToAPI(workerID uuid.UUID, in []service.WorkerQualification) specs.WorkerQualificationsResponse {
+	return specs.WorkerQualificationsResponse{
+		WorkerId:       workerID.String(),
+		Qualifications: arrayInArray(in, WorkerQualificationToAPI),
+	}
+}
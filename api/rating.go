@@ -0,0 +1,78 @@
+package api
+
+import (
+	"bio/auth"
+	"bio/service"
+	"bio/specs"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func (ctrl *Controller) RateApplication(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctx := r.Context()
+
+	reqRating := specs.RateApplicationPayload{}
+
+	err := json.NewDecoder(r.Body).Decode(&reqRating)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get rating json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return
+	}
+
+	if reqRating.Rating < service.MinRating || reqRating.Rating > service.MaxRating {
+		WithBadRequestError(ctx, w, service.ErrInvalidRating.Error())
+		return
+	}
+
+	review := ""
+	if reqRating.Review != nil {
+		review = *reqRating.Review
+	}
+
+	ctrl.handleApplicationAction(w, r, applicationId, "rate application",
+		func(ctx context.Context, srvc *service.Service, userID, id uuid.UUID) (*service.Application, error) {
+			return srvc.RateApplication(ctx, userID, id, reqRating.Rating, review)
+		})
+}
+
+func (ctrl *Controller) GetApplicationRatings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := zerolog.Ctx(ctx)
+
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		logger.Warn().Err(NoUserInTokenErr).Msg("get user from context")
+		WithUnauthorizedError(ctx, w)
+		return
+	}
+
+	report, err := ctrl.srvc.GetRatingReport(ctx, user.ID)
+	switch err {
+	case nil:
+		res := specs.RatingReportResponse{
+			Workers: arrayInArray(report.ByWorker, RatingStatToAPI),
+			Types:   arrayInArray(report.ByType, RatingStatToAPI),
+		}
+		WithStatusOK(ctx, w, res)
+	case service.ErrForbidden:
+		WithForbiddenError(ctx, w, "ratings are available only for moderators")
+	default:
+		fmt.Println("get application ratings: ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+	return
+}
+
+func RatingStatToAPI(in service.RatingStat) specs.RatingStat {
+	return specs.RatingStat{
+		Id:      in.ID.String(),
+		Count:   in.Count,
+		Average: in.Average,
+	}
+}
@@ -0,0 +1,258 @@
+package api
+
+import (
+	"bio/pagination"
+	"bio/service"
+	"bio/specs"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func GetWorkerAbsencePaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     200,
+		DefaultLimit: 50,
+		OrderByMappgin: map[string]string{
+			"starts_on": "wa.starts_on",
+		},
+	}
+}
+
+// withScheduleError maps errors of the schedule operations to responses.
+func withScheduleError(ctx context.Context, w http.ResponseWriter, name string, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		WithNotFoundError(ctx, w, "not found")
+	case errors.Is(err, service.ErrForbidden):
+		WithForbiddenError(ctx, w, "schedule is managed by the worker and moderators")
+	case errors.Is(err, service.ErrInvalidSchedule):
+		WithBadRequestError(ctx, w, "schedule is kept for workers, intervals have to end after they start and may not overlap")
+	default:
+		fmt.Println(name+": ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+}
+
+func (ctrl *Controller) GetWorkerSchedule(w http.ResponseWriter, r *http.Request, userId string) {
+	workerID, ok := parsePathID(r.Context(), w, "user", userId)
+	if !ok {
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withScheduleError, "get worker schedule",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			hours, err := srvc.GetWorkingHours(ctx, userID, workerID)
+			if err != nil {
+				return nil, err
+			}
+			return WorkerScheduleToAPI(workerID, hours), nil
+		})
+}
+
+func (ctrl *Controller) SetWorkerSchedule(w http.ResponseWriter, r *http.Request, userId string) {
+	ctx := r.Context()
+
+	workerID, ok := parsePathID(ctx, w, "user", userId)
+	if !ok {
+		return
+	}
+
+	req := specs.SetWorkerSchedulePayload{}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get schedule json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return
+	}
+
+	hours, err := arrayInArrayWithError(req.Hours, ApiToWorkingHours)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withScheduleError, "set worker schedule",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			saved, err := srvc.SetWorkingHours(ctx, userID, workerID, hours)
+			if err != nil {
+				return nil, err
+			}
+			return WorkerScheduleToAPI(workerID, saved), nil
+		})
+}
+
+func (ctrl *Controller) CreateWorkerAbsence(w http.ResponseWriter, r *http.Request, userId string) {
+	ctx := r.Context()
+
+	workerID, ok := parsePathID(ctx, w, "user", userId)
+	if !ok {
+		return
+	}
+
+	req := specs.CreateWorkerAbsencePayload{}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get absence json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return
+	}
+
+	absence := service.WorkerAbsence{
+		ID:        uuid.New(),
+		WorkerID:  workerID,
+		CreatedAt: time.Now().UTC(),
+		StartsOn:  req.StartsOn.Time,
+		EndsOn:    req.EndsOn.Time,
+		Reason:    service.AbsenceReason(req.Reason),
+		Comment:   req.Comment,
+	}
+
+	ctrl.handleEntityAction(w, r, withScheduleError, "create worker absence",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			created, err := srvc.CreateWorkerAbsence(ctx, userID, absence)
+			if err != nil {
+				return nil, err
+			}
+			return WorkerAbsenceToAPI(*created), nil
+		})
+}
+
+func (ctrl *Controller) ListWorkerAbsences(w http.ResponseWriter, r *http.Request, userId string, params specs.ListWorkerAbsencesParams) {
+	ctx := r.Context()
+
+	workerID, ok := parsePathID(ctx, w, "user", userId)
+	if !ok {
+		return
+	}
+
+	pgnPolitics, err := GetWorkerAbsencePaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.WorkerAbsenceFilter{
+		WorkerID:   workerID,
+		Pagination: pgnPolitics,
+	}
+
+	if params.From != nil {
+		filter.From = &params.From.Time
+	}
+
+	if params.To != nil {
+		filter.To = &params.To.Time
+	}
+
+	ctrl.handleEntityAction(w, r, withScheduleError, "list worker absences",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			absences, total, err := srvc.ListWorkerAbsences(ctx, userID, filter)
+			if err != nil {
+				return nil, err
+			}
+			return specs.ListWorkerAbsencesResponse{
+				Data: arrayInArray(absences, WorkerAbsenceToAPI),
+				Meta: specs.ResponseMetaTotal{
+					Total: total,
+				},
+			}, nil
+		})
+}
+
+func (ctrl *Controller) DeleteWorkerAbsence(w http.ResponseWriter, r *http.Request, absenceId string) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(absenceId)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("parse absence id")
+		WithBadRequestError(ctx, w, "invalid absence id")
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withScheduleError, "delete worker absence",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			return nil, srvc.DeleteWorkerAbsence(ctx, userID, id)
+		})
+}
+
+// parseClock converts "HH:MM" to minutes since the midnight.
+func parseClock(value string) (int, error) {
+	var hour, minute int
+
+	_, err := fmt.Sscanf(value, "%d:%d", &hour, &minute)
+	if err != nil || hour < 0 || hour > 24 || minute < 0 || minute > 59 || (hour == 24 && minute != 0) {
+		return 0, fmt.Errorf("invalid time of day %q", value)
+	}
+
+	return hour*60 + minute, nil
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func ApiToWorkingHours(in specs.WorkingHours) (service.WorkingHours, error) {
+	out := service.WorkingHours{Weekday: -1}
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(day.String(), string(in.Weekday)) {
+			out.Weekday = day
+		}
+	}
+
+	if out.Weekday < 0 {
+		return out, fmt.Errorf("invalid weekday %q", in.Weekday)
+	}
+
+	var err error
+
+	out.StartMinute, err = parseClock(in.StartsAt)
+	if err != nil {
+		return out, err
+	}
+
+	out.EndMinute, err = parseClock(in.EndsAt)
+	if err != nil {
+		return out, err
+	}
+
+	return out, nil
+}
+
+func WorkingHoursToAPI(in service.WorkingHours) specs.WorkingHours {
+	return specs.WorkingHours{
+		Weekday:  specs.WorkingHoursWeekday(strings.ToLower(in.Weekday.String())),
+		StartsAt: formatClock(in.StartMinute),
+		EndsAt:   formatClock(in.EndMinute),
+	}
+}
+
+func WorkerScheduleToAPI(workerID uuid.UUID, hours []service.WorkingHours) specs.WorkerScheduleResponse {
+	return specs.WorkerScheduleResponse{
+		WorkerId: workerID.String(),
+		Hours:    arrayInArray(hours, WorkingHoursToAPI),
+	}
+}
+
+func WorkerAbsenceToAPI(in service.WorkerAbsence) specs.WorkerAbsenceResponse {
+	return specs.WorkerAbsenceResponse{
+		Id:        in.ID.String(),
+		WorkerId:  in.WorkerID.String(),
+		CreatedAt: in.CreatedAt,
+		StartsOn:  openapi_types.Date{Time: in.StartsOn},
+		EndsOn:    openapi_types.Date{Time: in.EndsOn},
+		Reason:    specs.AbsenceReason(in.Reason),
+		Comment:   in.Comment,
+	}
+}
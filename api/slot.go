@@ -0,0 +1,170 @@
+package api
+
+import (
+	"bio/pagination"
+	"bio/service"
+	"bio/specs"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+func GetVisitSlotPaginationPolitics() pagination.PaginationPolitics {
+	return pagination.PaginationPolitics{
+		MaxLimit:     200,
+		DefaultLimit: 50,
+		OrderByMappgin: map[string]string{
+			"starts_at": "vs.starts_at",
+		},
+	}
+}
+
+// withVisitSlotError maps errors of the slot operations to responses.
+func withVisitSlotError(ctx context.Context, w http.ResponseWriter, name string, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		WithNotFoundError(ctx, w, "not found")
+	case errors.Is(err, service.ErrForbidden):
+		WithForbiddenError(ctx, w, "forbidden")
+	case errors.Is(err, service.ErrInvalidSlot):
+		WithBadRequestError(ctx, w, "slot has to end after it starts and start in the future")
+	case errors.Is(err, service.ErrSlotConflict):
+		WithStatusConflictError(ctx, w, "slot overlaps another slot or is booked")
+	case errors.Is(err, service.ErrSlotOffSchedule):
+		WithStatusConflictError(ctx, w, "slot is outside the working hours of the worker or on its absence")
+	default:
+		fmt.Println(name+": ", err)
+		WithInternalServerError(ctx, w, "")
+	}
+}
+
+func (ctrl *Controller) CreateVisitSlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req := specs.CreateVisitSlotPayload{}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get slot json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return
+	}
+
+	slot := service.VisitSlot{
+		ID:        uuid.New(),
+		CreatedAt: time.Now().UTC(),
+		StartsAt:  req.StartsAt.UTC(),
+		EndsAt:    req.EndsAt.UTC(),
+	}
+
+	ctrl.handleEntityAction(w, r, withVisitSlotError, "create visit slot",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			created, err := srvc.CreateVisitSlot(ctx, userID, slot)
+			if err != nil {
+				return nil, err
+			}
+			return VisitSlotToAPI(*created), nil
+		})
+}
+
+func (ctrl *Controller) DeleteVisitSlot(w http.ResponseWriter, r *http.Request, slotId string) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(slotId)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("parse slot id")
+		WithBadRequestError(ctx, w, "invalid slot id")
+		return
+	}
+
+	ctrl.handleEntityAction(w, r, withVisitSlotError, "delete visit slot",
+		func(ctx context.Context, srvc *service.Service, userID uuid.UUID) (interface{}, error) {
+			return nil, srvc.DeleteVisitSlot(ctx, userID, id)
+		})
+}
+
+func (ctrl *Controller) ListVisitSlots(w http.ResponseWriter, r *http.Request, userId string, params specs.ListVisitSlotsParams) {
+	ctx := r.Context()
+
+	workerID, err := uuid.Parse(userId)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("parse user id")
+		WithBadRequestError(ctx, w, "invalid user id")
+		return
+	}
+
+	pgnPolitics, err := GetVisitSlotPaginationPolitics().MakePagination(params.Pagination, params.Sort)
+	if err != nil {
+		WithBadRequestError(ctx, w, err.Error())
+		return
+	}
+
+	filter := service.VisitSlotFilter{
+		WorkerID:   workerID,
+		From:       params.From,
+		To:         params.To,
+		Available:  params.Available,
+		Pagination: pgnPolitics,
+	}
+
+	ctrl.handleEntityAction(w, r, withVisitSlotError, "list visit slots",
+		func(ctx context.Context, srvc *service.Service, _ uuid.UUID) (interface{}, error) {
+			slots, total, err := srvc.ListVisitSlots(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			return specs.ListVisitSlotsResponse{
+				Data: arrayInArray(slots, VisitSlotToAPI),
+				Meta: specs.ResponseMetaTotal{
+					Total: total,
+				},
+			}, nil
+		})
+}
+
+func (ctrl *Controller) BookApplicationVisitSlot(w http.ResponseWriter, r *http.Request, applicationId string) {
+	ctx := r.Context()
+
+	req := specs.BookVisitSlotPayload{}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("get booking json body")
+		WithBadRequestError(ctx, w, "incorrect json")
+		return
+	}
+
+	slotID, err := uuid.Parse(req.SlotId)
+	if err != nil {
+		WithBadRequestError(ctx, w, "invalid slot id")
+		return
+	}
+
+	ctrl.handleApplicationAction(w, r, applicationId, "book visit slot",
+		func(ctx context.Context, srvc *service.Service, userID, id uuid.UUID) (*service.Application, error) {
+			return srvc.BookVisitSlot(ctx, userID, id, slotID)
+		})
+}
+
+func VisitSlotToAPI(in service.VisitSlot) specs.VisitSlotResponse {
+	out := specs.VisitSlotResponse{
+		Id:        in.ID.String(),
+		WorkerId:  in.WorkerID.String(),
+		CreatedAt: in.CreatedAt,
+		StartsAt:  in.StartsAt,
+		EndsAt:    in.EndsAt,
+		BookedAt:  in.BookedAt,
+	}
+
+	if in.ApplicationID != nil {
+		out.ApplicationId = toPoint(in.ApplicationID.String())
+	}
+
+	return out
+}
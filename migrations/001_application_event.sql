@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS application_event (
+    id             UUID PRIMARY KEY,
+    application_id UUID        NOT NULL REFERENCES application (id),
+    created_at     TIMESTAMPTZ NOT NULL,
+    author_id      UUID        NOT NULL REFERENCES users (id),
+    field          TEXT        NOT NULL,
+    old_value      TEXT,
+    new_value      TEXT
+);
+
+CREATE INDEX IF NOT EXISTS application_event_application_id_idx ON application_event (application_id, created_at);
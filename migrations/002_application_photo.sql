@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS application_photo (
+    application_id UUID        NOT NULL REFERENCES application (id),
+    photo_id       UUID        NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (application_id, photo_id)
+);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS photo (
+    id           UUID PRIMARY KEY,
+    created_at   TIMESTAMPTZ NOT NULL,
+    creator_id   UUID        NOT NULL REFERENCES users (id),
+    content_type TEXT        NOT NULL,
+    size         BIGINT      NOT NULL
+);
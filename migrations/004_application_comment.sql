@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS application_comment (
+    id             UUID PRIMARY KEY,
+    application_id UUID        NOT NULL REFERENCES application (id),
+    author_id      UUID        NOT NULL REFERENCES users (id),
+    created_at     TIMESTAMPTZ NOT NULL,
+    updated_at     TIMESTAMPTZ NOT NULL,
+    deleted_at     TIMESTAMPTZ,
+    text           TEXT        NOT NULL,
+    internal       BOOLEAN     NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS application_comment_application_id_idx ON application_comment (application_id, created_at);
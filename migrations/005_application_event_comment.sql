@@ -0,0 +1 @@
+ALTER TABLE application_event ADD COLUMN IF NOT EXISTS comment TEXT;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS worker_qualification (
+    worker_id  UUID NOT NULL REFERENCES users (id),
+    type_id    UUID NOT NULL REFERENCES application_type (id),
+    subtype_id UUID REFERENCES application_subtype (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS worker_qualification_uniq_idx
+    ON worker_qualification (worker_id, type_id, COALESCE(subtype_id, '00000000-0000-0000-0000-000000000000'));
+
+-- events made by the dispatcher have no author
+ALTER TABLE application_event ALTER COLUMN author_id DROP NOT NULL;
@@ -0,0 +1,9 @@
+ALTER TABLE application_subtype
+    ADD COLUMN IF NOT EXISTS response_minutes   INTEGER,
+    ADD COLUMN IF NOT EXISTS resolution_minutes INTEGER;
+
+ALTER TABLE application
+    ADD COLUMN IF NOT EXISTS response_due_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS due_at          TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS application_due_at_idx ON application (due_at);
@@ -0,0 +1,3 @@
+ALTER TABLE application ADD COLUMN IF NOT EXISTS priority TEXT NOT NULL DEFAULT 'normal';
+
+CREATE INDEX IF NOT EXISTS application_emergency_idx ON application (created_at) WHERE priority = 'emergency';
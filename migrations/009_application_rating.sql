@@ -0,0 +1,4 @@
+ALTER TABLE application
+    ADD COLUMN IF NOT EXISTS rating   SMALLINT CHECK (rating BETWEEN 1 AND 5),
+    ADD COLUMN IF NOT EXISTS review   TEXT,
+    ADD COLUMN IF NOT EXISTS rated_at TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+ALTER TABLE application
+    ADD COLUMN IF NOT EXISTS done_at      TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS reopen_count INTEGER NOT NULL DEFAULT 0;
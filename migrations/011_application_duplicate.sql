@@ -0,0 +1,6 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE application
+    ADD COLUMN IF NOT EXISTS duplicate_of UUID REFERENCES application (id);
+
+CREATE INDEX IF NOT EXISTS application_text_trgm_idx ON application USING GIN (text gin_trgm_ops);
@@ -0,0 +1,5 @@
+ALTER TABLE application
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+        GENERATED ALWAYS AS (to_tsvector('russian', coalesce(text, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS application_search_vector_idx ON application USING GIN (search_vector);
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS building (
+    id         UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL,
+    deleted_at TIMESTAMPTZ,
+    address    TEXT        NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS entrance (
+    id          UUID PRIMARY KEY,
+    building_id UUID NOT NULL REFERENCES building (id),
+    deleted_at  TIMESTAMPTZ,
+    number      TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS entrance_building_id_idx ON entrance (building_id);
+
+CREATE TABLE IF NOT EXISTS apartment (
+    id          UUID PRIMARY KEY,
+    building_id UUID NOT NULL REFERENCES building (id),
+    entrance_id UUID REFERENCES entrance (id),
+    deleted_at  TIMESTAMPTZ,
+    number      TEXT NOT NULL,
+    floor       INTEGER
+);
+
+CREATE INDEX IF NOT EXISTS apartment_building_id_idx ON apartment (building_id, entrance_id);
+
+-- applications created before the premises were introduced have no building
+ALTER TABLE application
+    ADD COLUMN IF NOT EXISTS building_id  UUID REFERENCES building (id),
+    ADD COLUMN IF NOT EXISTS apartment_id UUID REFERENCES apartment (id);
+
+CREATE INDEX IF NOT EXISTS application_premises_idx ON application (building_id, apartment_id);
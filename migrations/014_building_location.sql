@@ -0,0 +1,14 @@
+ALTER TABLE building
+    ADD COLUMN IF NOT EXISTS latitude  DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'building_location_check') THEN
+        ALTER TABLE building
+            ADD CONSTRAINT building_location_check CHECK ((latitude IS NULL) = (longitude IS NULL));
+    END IF;
+END
+$$;
+
+CREATE INDEX IF NOT EXISTS building_location_idx ON building (latitude, longitude);
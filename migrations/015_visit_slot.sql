@@ -0,0 +1,17 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE IF NOT EXISTS visit_slot (
+    id             UUID PRIMARY KEY,
+    worker_id      UUID        NOT NULL REFERENCES users (id),
+    created_at     TIMESTAMPTZ NOT NULL,
+    starts_at      TIMESTAMPTZ NOT NULL,
+    ends_at        TIMESTAMPTZ NOT NULL,
+    application_id UUID REFERENCES application (id),
+    booked_at      TIMESTAMPTZ,
+    CHECK (ends_at > starts_at),
+    -- the service checks it too, the constraint is the last line of defence against double booking
+    EXCLUDE USING gist (worker_id WITH =, tstzrange(starts_at, ends_at) WITH &&) WHERE (application_id IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS visit_slot_worker_id_idx ON visit_slot (worker_id, starts_at);
+CREATE INDEX IF NOT EXISTS visit_slot_application_id_idx ON visit_slot (application_id);
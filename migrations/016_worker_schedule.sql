@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS worker_hours (
+    worker_id    UUID     NOT NULL REFERENCES users (id),
+    weekday      SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_minute SMALLINT NOT NULL CHECK (start_minute BETWEEN 0 AND 1439),
+    end_minute   SMALLINT NOT NULL CHECK (end_minute BETWEEN 1 AND 1440),
+    CHECK (end_minute > start_minute)
+);
+
+CREATE INDEX IF NOT EXISTS worker_hours_worker_id_idx ON worker_hours (worker_id, weekday);
+
+CREATE TABLE IF NOT EXISTS worker_absence (
+    id         UUID PRIMARY KEY,
+    worker_id  UUID        NOT NULL REFERENCES users (id),
+    created_at TIMESTAMPTZ NOT NULL,
+    starts_on  DATE        NOT NULL,
+    ends_on    DATE        NOT NULL,
+    reason     TEXT        NOT NULL,
+    comment    TEXT,
+    deleted_at TIMESTAMPTZ,
+    CHECK (ends_on >= starts_on)
+);
+
+CREATE INDEX IF NOT EXISTS worker_absence_worker_id_idx ON worker_absence (worker_id, starts_on);
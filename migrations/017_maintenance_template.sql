@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS maintenance_template (
+    id           UUID PRIMARY KEY,
+    created_at   TIMESTAMPTZ NOT NULL,
+    creator_id   UUID        NOT NULL REFERENCES users (id),
+    type         UUID        NOT NULL,
+    subtype      UUID        NOT NULL,
+    building_id  UUID        NOT NULL REFERENCES building (id),
+    apartment_id UUID REFERENCES apartment (id),
+    text         TEXT        NOT NULL,
+    priority     TEXT        NOT NULL DEFAULT 'normal',
+    performer_id UUID REFERENCES users (id),
+    schedule     TEXT        NOT NULL,
+    active       BOOLEAN     NOT NULL DEFAULT TRUE,
+    next_run_at  TIMESTAMPTZ,
+    last_run_at  TIMESTAMPTZ,
+    deleted_at   TIMESTAMPTZ
+);
+
+-- the scheduler looks for due templates only
+CREATE INDEX IF NOT EXISTS maintenance_template_next_run_at_idx ON maintenance_template (next_run_at)
+    WHERE active AND deleted_at IS NULL;
+
+ALTER TABLE application ADD COLUMN IF NOT EXISTS recurrence_id UUID REFERENCES maintenance_template (id);
+
+CREATE INDEX IF NOT EXISTS application_recurrence_id_idx ON application (recurrence_id);
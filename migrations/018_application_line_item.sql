@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS application_line_item (
+    id             UUID PRIMARY KEY,
+    application_id UUID           NOT NULL REFERENCES application (id),
+    author_id      UUID           NOT NULL REFERENCES users (id),
+    created_at     TIMESTAMPTZ    NOT NULL,
+    updated_at     TIMESTAMPTZ    NOT NULL,
+    material       TEXT           NOT NULL DEFAULT '',
+    quantity       NUMERIC(12, 3) NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    unit_price     BIGINT         NOT NULL DEFAULT 0 CHECK (unit_price >= 0),
+    labour_hours   NUMERIC(8, 2)  NOT NULL DEFAULT 0 CHECK (labour_hours >= 0),
+    deleted_at     TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS application_line_item_application_id_idx ON application_line_item (application_id)
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS subtype_checklist_item (
+    subtype_id UUID    NOT NULL REFERENCES application_subtype (id),
+    position   INT     NOT NULL,
+    title      TEXT    NOT NULL,
+    required   BOOLEAN NOT NULL DEFAULT TRUE,
+    PRIMARY KEY (subtype_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS application_checklist_item (
+    id             UUID PRIMARY KEY,
+    application_id UUID        NOT NULL REFERENCES application (id),
+    position       INT         NOT NULL,
+    title          TEXT        NOT NULL,
+    required       BOOLEAN     NOT NULL,
+    done_at        TIMESTAMPTZ,
+    done_by        UUID REFERENCES users (id),
+    UNIQUE (application_id, position)
+);
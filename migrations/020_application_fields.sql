@@ -0,0 +1,5 @@
+ALTER TABLE application_type ADD COLUMN IF NOT EXISTS fields_schema JSONB;
+
+ALTER TABLE application ADD COLUMN IF NOT EXISTS fields JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS application_fields_idx ON application USING GIN (fields jsonb_path_ops);
@@ -0,0 +1,7 @@
+ALTER TABLE application_type
+    ADD COLUMN IF NOT EXISTS position    INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
+
+ALTER TABLE application_subtype
+    ADD COLUMN IF NOT EXISTS position    INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
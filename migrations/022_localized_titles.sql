@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS application_type_title (
+    type_id UUID NOT NULL REFERENCES application_type (id),
+    locale  TEXT NOT NULL,
+    title   TEXT NOT NULL,
+    PRIMARY KEY (type_id, locale)
+);
+
+CREATE TABLE IF NOT EXISTS application_subtype_title (
+    subtype_id UUID NOT NULL REFERENCES application_subtype (id),
+    locale     TEXT NOT NULL,
+    title      TEXT NOT NULL,
+    PRIMARY KEY (subtype_id, locale)
+);
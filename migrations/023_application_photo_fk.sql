@@ -0,0 +1,8 @@
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'application_photo_photo_id_fkey') THEN
+        ALTER TABLE application_photo
+            ADD CONSTRAINT application_photo_photo_id_fkey FOREIGN KEY (photo_id) REFERENCES photo (id);
+    END IF;
+END
+$$;
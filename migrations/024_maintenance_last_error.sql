@@ -0,0 +1 @@
+ALTER TABLE maintenance_template ADD COLUMN IF NOT EXISTS last_error TEXT;
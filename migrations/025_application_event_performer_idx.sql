@@ -0,0 +1,3 @@
+-- the dispatcher looks for the last assignment of every candidate
+CREATE INDEX IF NOT EXISTS application_event_performer_idx ON application_event (new_value, created_at)
+    WHERE field = 'performer_id';
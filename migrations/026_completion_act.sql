@@ -0,0 +1,6 @@
+-- the content of the completion act taken when the application is done
+CREATE TABLE IF NOT EXISTS completion_act (
+    application_id UUID PRIMARY KEY REFERENCES application (id),
+    done_at        TIMESTAMPTZ NOT NULL,
+    snapshot       JSONB       NOT NULL
+);
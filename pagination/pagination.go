@@ -31,6 +31,8 @@ type Pagination struct {
 type OrderBy struct {
 	Sortname string
 	Desc     bool
+	// Expr replaces Sortname when the ordering needs query arguments, e.g. a search rank.
+	Expr sqb.Col
 }
 
 func NewOrderByFields(sortname string, desc bool) *OrderBy {
@@ -125,10 +127,15 @@ func (p *Pagination) Apply(q *sqb.SelectStmt) *sqb.SelectStmt {
 	orderByStmt := []sqb.OrderByElem{}
 
 	for _, orderBy := range p.OrderBy {
-		ob := sqb.Asc(sqb.Column(orderBy.Sortname))
+		var col sqb.Col = sqb.Column(orderBy.Sortname)
+		if orderBy.Expr != nil {
+			col = orderBy.Expr
+		}
+
+		ob := sqb.Asc(col)
 
 		if orderBy.Desc {
-			ob = sqb.Desc(sqb.Column(orderBy.Sortname))
+			ob = sqb.Desc(col)
 		}
 
 		orderByStmt = append(orderByStmt, ob)
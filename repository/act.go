@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+func (r *Repo) SaveActSnapshot(ctx context.Context, snapshot service.ActSnapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO completion_act (application_id, done_at, snapshot)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (application_id) DO UPDATE SET done_at = EXCLUDED.done_at, snapshot = EXCLUDED.snapshot`
+
+	_, err = r.tx.ExecContext(ctx, query, snapshot.ApplicationID, snapshot.DoneAt, string(data))
+
+	return err
+}
+
+func (r *Repo) GetActSnapshot(ctx context.Context, applicationID uuid.UUID) (*service.ActSnapshot, error) {
+	query := `SELECT snapshot FROM completion_act WHERE application_id = $1`
+
+	var data []byte
+
+	err := r.tx.QueryRowContext(ctx, query, applicationID).Scan(&data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	snapshot := &service.ActSnapshot{}
+
+	err = json.Unmarshal(data, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
@@ -6,28 +6,87 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/vagruchi/sqb"
 )
 
+// applicationColumns are the columns of the application table read by applicationFields.
+var applicationColumns = []sqb.Col{
+	sqb.Column(`a.id`), sqb.Column(`a.created_at`), sqb.Column(`a.creator_id`), sqb.Column(`a.updated_at`),
+	sqb.Column(`a.status`), sqb.Column(`a.type`), sqb.Column(`a.subtype`), sqb.Column(`a.text`),
+	sqb.Column(`a.performer_id`), sqb.Column(`a.performer_time`),
+	sqb.Column(`a.response_due_at`), sqb.Column(`a.due_at`), sqb.Column(`a.priority`),
+	sqb.Column(`a.rating`), sqb.Column(`a.review`), sqb.Column(`a.rated_at`),
+	sqb.Column(`a.done_at`), sqb.Column(`a.reopen_count`), sqb.Column(`a.duplicate_of`),
+	sqb.Column(`a.building_id`), sqb.Column(`a.apartment_id`), sqb.Column(`b.latitude`), sqb.Column(`b.longitude`),
+	sqb.Column(`a.recurrence_id`), sqb.Column(`a.fields`),
+	sqb.Column(applicationMaterialsCost), sqb.Column(applicationLabourHours),
+}
+
+// applicationTable joins the application with its building, the building is used for the location.
+var applicationTable = sqb.JB(sqb.TableName(`application`).As(`a`)).
+	LeftJoin(sqb.TableName(`building`).As(`b`), sqb.Eq(sqb.Column(`a.building_id`), sqb.Column(`b.id`)))
+
+// applicationColumnList is the select list of applicationColumns for queries written by hand.
+func applicationColumnList() string {
+	names := make([]string, len(applicationColumns))
+	for i, col := range applicationColumns {
+		names[i] = string(col.(sqb.Column))
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func applicationFields(appl *service.Application) []interface{} {
+	latitude, longitude := scanLocation(&appl.Location)
+
+	return []interface{}{
+		&appl.ID, &appl.CreatedAt, &appl.CreatorID, &appl.UpdatedAt,
+		&appl.Status, &appl.Type, &appl.SubType, &appl.Text,
+		&appl.PerformerID, &appl.PerformerTime,
+		&appl.ResponseDueAt, &appl.DueAt, &appl.Priority,
+		&appl.Rating, &appl.Review, &appl.RatedAt,
+		&appl.DoneAt, &appl.ReopenCount, &appl.DuplicateOfID,
+		&appl.BuildingID, &appl.ApartmentID, latitude, longitude,
+		&appl.RecurrenceID, scanJSONObject(&appl.Fields),
+		&appl.Costs.MaterialsCost, &appl.Costs.LabourHours,
+	}
+}
+
 func (r *Repo) CreateApplication(ctx context.Context, appl service.Application) error {
-	query := `INSERT INTO application (id, created_at, creator_id, status, type, subtype, text)
-	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	fields, err := jsonObjectArg(appl.Fields)
+	if err != nil {
+		return err
+	}
 
-	_, err := r.tx.ExecContext(ctx, query,
-		appl.ID, appl.CreatedAt, appl.CreatorID, appl.Status, appl.Type, appl.SubType, appl.Text)
+	query := `INSERT INTO application (id, created_at, creator_id, status, type, subtype, text, response_due_at, due_at, priority,
+		building_id, apartment_id, recurrence_id, fields)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, COALESCE($14::jsonb, '{}'))`
 
-	return err
+	_, err = r.tx.ExecContext(ctx, query,
+		appl.ID, appl.CreatedAt, appl.CreatorID, appl.Status, appl.Type, appl.SubType, appl.Text, appl.ResponseDueAt, appl.DueAt,
+		appl.Priority, appl.BuildingID, appl.ApartmentID, appl.RecurrenceID, fields)
+	if err != nil {
+		return err
+	}
+
+	return r.createApplicationPhotos(ctx, appl.ID, appl.PhotoIDs)
 }
 
 func (r *Repo) GetApplication(ctx context.Context, id uuid.UUID) (*service.Application, error) {
-	query := `SELECT id, created_at, creator_id, updated_at, status, type, subtype, text, performer_id, performer_time
-	FROM application AS a
-	WHERE a.id = $1`
+	query := sqb.From(applicationTable).
+		Select(applicationColumns...).
+		Where(sqb.Eq(sqb.Column(`a.id`), sqb.Arg{V: id}))
 
-	rows, err := r.tx.QueryContext(ctx, query, id)
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -36,17 +95,44 @@ func (r *Repo) GetApplication(ctx context.Context, id uuid.UUID) (*service.Appli
 	appl := &service.Application{}
 
 	if !rows.Next() {
-		return nil, sql.ErrNoRows
+		return nil, service.ErrNotFound
 	}
-	err = rows.Scan(&appl.ID, &appl.CreatedAt, &appl.CreatorID, &appl.UpdatedAt, &appl.Status, &appl.Type, &appl.SubType, &appl.Text,
-		&appl.PerformerID, &appl.PerformerTime)
+	err = rows.Scan(applicationFields(appl)...)
 	if err != nil {
 		return nil, err
 	}
+	rows.Close()
+
+	photos, err := r.listApplicationPhotos(ctx, []uuid.UUID{appl.ID})
+	if err != nil {
+		return nil, err
+	}
+	appl.PhotoIDs = photos[appl.ID]
+
+	slots, err := r.listApplicationVisitSlots(ctx, []uuid.UUID{appl.ID})
+	if err != nil {
+		return nil, err
+	}
+	appl.VisitSlot = slots[appl.ID]
 
 	return appl, nil
 }
 
+var (
+	emergencyFirstExpr = fmt.Sprintf(`a.priority = '%s'`, service.ApplPriorityEmergency)
+
+	// ApplicationPriorityRankExpr orders applications by priority, see service.ApplicationPriority.Rank.
+	ApplicationPriorityRankExpr = fmt.Sprintf(`CASE a.priority WHEN '%s' THEN 3 WHEN '%s' THEN 2 WHEN '%s' THEN 1 ELSE 0 END`,
+		service.ApplPriorityEmergency, service.ApplPriorityHigh, service.ApplPriorityNormal)
+)
+
+// overdueExpr matches open applications that missed the response or the resolution deadline,
+// see service.Application.IsOverdue.
+var overdueExpr = fmt.Sprintf(`COALESCE(
+	(a.status IN ('%[1]s', '%[3]s') AND a.response_due_at < now()) OR
+	(a.status IN ('%[1]s', '%[2]s', '%[3]s') AND a.due_at < now()), false)`,
+	service.ApplStatusCreated, service.ApplStatusInProgress, service.ApplStatusReopened)
+
 func addApplicationFilters(q *sqb.SelectStmt, filters service.ApplicationFilter, isCount bool) *sqb.SelectStmt {
 	query := *q
 
@@ -62,12 +148,55 @@ func addApplicationFilters(q *sqb.SelectStmt, filters service.ApplicationFilter,
 		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`a.status`), sqb.Arg{V: filters.Status}))...)
 	}
 
+	if filters.Priority != "" {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`a.priority`), sqb.Arg{V: filters.Priority}))...)
+	}
+
 	if filters.Type != nil {
 		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column("a.type"), sqb.Arg{V: *filters.Type}))...)
 	}
 
+	if filters.BuildingID != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`a.building_id`), sqb.Arg{V: *filters.BuildingID}))...)
+	}
+
+	if filters.ApartmentID != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`a.apartment_id`), sqb.Arg{V: *filters.ApartmentID}))...)
+	}
+
+	if filters.RecurrenceID != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`a.recurrence_id`), sqb.Arg{V: *filters.RecurrenceID}))...)
+	}
+
+	if len(filters.Fields) != 0 {
+		query = query.Where(append(query.WhereStmt.Exprs, fieldsMatch(filters.Fields))...)
+	}
+
+	if filters.Query != "" {
+		query = query.Where(append(query.WhereStmt.Exprs, searchMatch(filters.Query))...)
+	}
+
+	if filters.BoundingBox != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, withinBox(*filters.BoundingBox)...)...)
+	}
+
+	if filters.Point != nil && filters.Radius != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, withinRadius(*filters.Point, *filters.Radius))...)
+	}
+
+	if filters.Overdue != nil {
+		overdue := sqb.Raw(overdueExpr)
+		if !*filters.Overdue {
+			overdue = sqb.Raw(`NOT ` + overdueExpr)
+		}
+		query = query.Where(append(query.WhereStmt.Exprs, overdue)...)
+	}
+
 	if !isCount {
+		filters.Pagination.OrderBy = withSearchRank(filters.Pagination.OrderBy, filters.Query)
+		filters.Pagination.OrderBy = withDistance(filters.Pagination.OrderBy, filters.Point)
 		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByDesc(emergencyFirstExpr)
 			filters.Pagination.AddOrderByAsc(`a.created_at`)
 		}
 		query = *filters.Pagination.Apply(&query)
@@ -78,7 +207,7 @@ func addApplicationFilters(q *sqb.SelectStmt, filters service.ApplicationFilter,
 
 func (r *Repo) countApplications(ctx context.Context, filters service.ApplicationFilter) (int, error) {
 	query := sqb.From(
-		sqb.JB(sqb.TableName(`application`).As(`a`)).
+		applicationTable.
 			InnerJoin(sqb.TableName(`application_type`).As(`at`), sqb.Eq(sqb.Column(`a.type`), sqb.Column(`at.id`)))).
 		Select(sqb.Count(sqb.Column(`a.id`)))
 
@@ -103,11 +232,9 @@ func (r *Repo) ListApplication(ctx context.Context, filters service.ApplicationF
 	}
 
 	query := sqb.From(
-		sqb.JB(sqb.TableName(`application`).As(`a`)).
+		applicationTable.
 			InnerJoin(sqb.TableName(`application_type`).As(`at`), sqb.Eq(sqb.Column(`a.type`), sqb.Column(`at.id`)))).
-		Select(sqb.Column(`a.id`), sqb.Column(`a.created_at`), sqb.Column(`a.creator_id`), sqb.Column(`a.updated_at`),
-			sqb.Column(`a.status`), sqb.Column(`a.type`), sqb.Column(`a.subtype`), sqb.Column(`a.text`),
-			sqb.Column(`a.performer_id`), sqb.Column(`a.performer_time`))
+		Select(applicationColumns...)
 
 	query = *addApplicationFilters(&query, filters, false)
 
@@ -128,8 +255,7 @@ func (r *Repo) ListApplication(ctx context.Context, filters service.ApplicationF
 	for rows.Next() {
 		appl := &service.Application{}
 
-		err = rows.Scan(&appl.ID, &appl.CreatedAt, &appl.CreatorID, &appl.UpdatedAt,
-			&appl.Status, &appl.Type, &appl.SubType, &appl.Text, &appl.PerformerID, &appl.PerformerTime)
+		err = rows.Scan(applicationFields(appl)...)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -140,10 +266,41 @@ func (r *Repo) ListApplication(ctx context.Context, filters service.ApplicationF
 	if err != nil {
 		return nil, 0, err
 	}
+	rows.Close()
+
+	err = r.fillApplications(ctx, applications)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	return applications, total, nil
 }
 
+// fillApplications loads the photos and the visit slots of the applications with a query per table.
+func (r *Repo) fillApplications(ctx context.Context, applications []*service.Application) error {
+	applicationIDs := make([]uuid.UUID, len(applications))
+	for i := range applications {
+		applicationIDs[i] = applications[i].ID
+	}
+
+	photos, err := r.listApplicationPhotos(ctx, applicationIDs)
+	if err != nil {
+		return err
+	}
+
+	slots, err := r.listApplicationVisitSlots(ctx, applicationIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, appl := range applications {
+		appl.PhotoIDs = photos[appl.ID]
+		appl.VisitSlot = slots[appl.ID]
+	}
+
+	return nil
+}
+
 func (r *Repo) UpdateApplication(ctx context.Context, appl service.Application) error {
 	uptTime := time.Now().UTC()
 
@@ -169,6 +326,13 @@ func (r *Repo) UpdateApplication(ctx context.Context, appl service.Application)
 		})
 	}
 
+	if appl.Priority != "" {
+		update.Set = append(update.Set, sqb.SetArg{
+			Key:   sqb.Column(`priority`),
+			Value: sqb.Arg{V: appl.Priority},
+		})
+	}
+
 	if appl.PerformerID != nil {
 		update.Set = append(update.Set, sqb.SetArg{
 			Key:   sqb.Column(`performer_id`),
@@ -183,7 +347,49 @@ func (r *Repo) UpdateApplication(ctx context.Context, appl service.Application)
 		})
 	}
 
-	if len(update.Set) == 1 {
+	if appl.DoneAt != nil {
+		update.Set = append(update.Set, sqb.SetArg{
+			Key:   sqb.Column(`done_at`),
+			Value: sqb.Arg{V: appl.DoneAt},
+		})
+	}
+
+	if appl.ResponseDueAt != nil {
+		update.Set = append(update.Set, sqb.SetArg{
+			Key:   sqb.Column(`response_due_at`),
+			Value: sqb.Arg{V: appl.ResponseDueAt},
+		})
+	}
+
+	if appl.DueAt != nil {
+		update.Set = append(update.Set, sqb.SetArg{
+			Key:   sqb.Column(`due_at`),
+			Value: sqb.Arg{V: appl.DueAt},
+		})
+	}
+
+	if appl.DuplicateOfID != nil {
+		update.Set = append(update.Set, sqb.SetArg{
+			Key:   sqb.Column(`duplicate_of`),
+			Value: sqb.Arg{V: *appl.DuplicateOfID},
+		})
+	}
+
+	if appl.ReopenCount != 0 {
+		update.Set = append(update.Set, sqb.SetArg{
+			Key:   sqb.Column(`reopen_count`),
+			Value: sqb.Arg{V: appl.ReopenCount},
+		})
+	}
+
+	if appl.Fields != nil {
+		update.Set = append(update.Set, sqb.SetArg{
+			Key:   sqb.Column(`fields`),
+			Value: jsonbArg(appl.Fields),
+		})
+	}
+
+	if len(update.Set) == 1 && len(appl.AddPhotoIDs) == 0 && len(appl.RemovePhotoIDs) == 0 {
 		return errors.New("nothing update")
 	}
 
@@ -193,27 +399,128 @@ func (r *Repo) UpdateApplication(ctx context.Context, appl service.Application)
 	}
 
 	_, err = r.tx.ExecContext(ctx, rawQuery, args...)
+	if err != nil {
+		return err
+	}
+
+	if len(appl.RemovePhotoIDs) != 0 {
+		err = r.deleteApplicationPhotos(ctx, appl.ID, appl.RemovePhotoIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.createApplicationPhotos(ctx, appl.ID, appl.AddPhotoIDs)
+}
+
+func (r *Repo) ClearApplicationPerformer(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE application
+	SET performer_id = NULL, performer_time = NULL, updated_at = $1
+	WHERE id = $2`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		time.Now().UTC(), id)
 
 	return err
 }
 
-func (r *Repo) countApplicationTypes(ctx context.Context, filters service.ApplicationFilter) (int, error) {
+var applicationTypeColumns = []sqb.Col{
+	sqb.Column(`at.id`), sqb.Column(`at.title`), sqb.Column(`at.fields_schema`),
+	sqb.Column(`at.position`), sqb.Column(`at.archived_at`),
+}
+
+func applicationTypeFields(applType *service.ApplicationType) []interface{} {
+	return []interface{}{
+		&applType.ID, &applType.Title, scanJSONObject(&applType.FieldsSchema),
+		&applType.Position, &applType.ArchivedAt,
+	}
+}
+
+// CreateApplicationType adds the type after the existing types.
+func (r *Repo) CreateApplicationType(ctx context.Context, applType service.ApplicationType) error {
+	fieldsSchema, err := jsonObjectArg(applType.FieldsSchema)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO application_type (id, title, fields_schema, position)
+	VALUES ($1, $2, $3, (SELECT COALESCE(max(position) + 1, 0) FROM application_type))`
+
+	_, err = r.tx.ExecContext(ctx, query, applType.ID, applType.Title, fieldsSchema)
+	if err != nil {
+		return err
+	}
+
+	return r.replaceTitles(ctx, applicationTypeTitles, applType.ID, applType.Titles)
+}
+
+func (r *Repo) GetApplicationType(ctx context.Context, id uuid.UUID) (*service.ApplicationType, error) {
+	query := sqb.From(sqb.TableName(`application_type`).As(`at`)).
+		Select(applicationTypeColumns...).
+		Where(sqb.Eq(sqb.Column(`at.id`), sqb.Arg{V: id}))
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, err
+	}
+
+	applType := &service.ApplicationType{}
+
+	err = r.tx.QueryRowContext(ctx, rawquery, args...).Scan(applicationTypeFields(applType)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	titles, err := r.listTitles(ctx, applicationTypeTitles, []uuid.UUID{applType.ID})
+	if err != nil {
+		return nil, err
+	}
+	applType.Titles = titles[applType.ID]
+
+	return applType, nil
+}
+
+func addApplicationTypeFilters(q *sqb.SelectStmt, filters service.ApplicationTypeFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	if filters.Search != "" {
+		query = query.Where(append(query.WhereStmt.Exprs,
+			sqb.BinaryOp(sqb.Column(`at.title`), `ILIKE`, sqb.Arg{V: "%" + filters.Search + "%"}))...)
+	}
+
+	if !filters.IncludeArchived {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.Raw(`at.archived_at IS NULL`))...)
+	}
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByAsc(`at.position`)
+			filters.Pagination.AddOrderByAsc(`at.title`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+func (r *Repo) countApplicationTypes(ctx context.Context, filters service.ApplicationTypeFilter) (int, error) {
 	query := sqb.From(sqb.TableName(`application_type`).As(`at`)).
 		Select(sqb.Count(sqb.Column(`at.id`)))
 
-	query = *addApplicationFilters(&query, filters, true)
+	query = *addApplicationTypeFilters(&query, filters, true)
 
 	rawquery, args, err := sqb.ToPostgreSql(query)
 	if err != nil {
 		return 0, err
 	}
 
-	fmt.Println(rawquery)
-
 	return count(ctx, r.tx, rawquery, args)
 }
 
-func (r *Repo) ListApplicationTypes(ctx context.Context, filters service.ApplicationFilter) ([]service.ApplicationType, int, error) {
+func (r *Repo) ListApplicationTypes(ctx context.Context, filters service.ApplicationTypeFilter) ([]service.ApplicationType, int, error) {
 	total, err := r.countApplicationTypes(ctx, filters)
 	if err != nil {
 		return nil, 0, err
@@ -224,17 +531,15 @@ func (r *Repo) ListApplicationTypes(ctx context.Context, filters service.Applica
 	}
 
 	query := sqb.From(sqb.TableName(`application_type`).As(`at`)).
-		Select(sqb.Column(`at.id`), sqb.Column(`at.title`))
+		Select(applicationTypeColumns...)
 
-	query = *addApplicationFilters(&query, filters, false)
+	query = *addApplicationTypeFilters(&query, filters, false)
 
 	rawquery, args, err := sqb.ToPostgreSql(query)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	fmt.Println(rawquery)
-
 	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
 	if err != nil {
 		return nil, 0, err
@@ -247,7 +552,7 @@ func (r *Repo) ListApplicationTypes(ctx context.Context, filters service.Applica
 	for rows.Next() {
 		addr := service.ApplicationType{}
 
-		err = rows.Scan(&addr.ID, &addr.Title)
+		err = rows.Scan(applicationTypeFields(&addr)...)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -258,29 +563,162 @@ func (r *Repo) ListApplicationTypes(ctx context.Context, filters service.Applica
 	if err != nil {
 		return nil, 0, err
 	}
+	rows.Close()
+
+	typeIDs := make([]uuid.UUID, len(applicationTypes))
+	for i := range applicationTypes {
+		typeIDs[i] = applicationTypes[i].ID
+	}
+
+	titles, err := r.listTitles(ctx, applicationTypeTitles, typeIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range applicationTypes {
+		applicationTypes[i].Titles = titles[applicationTypes[i].ID]
+	}
 
 	return applicationTypes, total, nil
 }
 
-func (r *Repo) countApplicationSubTypes(ctx context.Context, filters service.ApplicationFilter) (int, error) {
-	query := sqb.From(
-		sqb.JB(sqb.TableName(`application_subtype`).As(`ast`)).
-			InnerJoin(sqb.TableName(`application_type`).As(`at`), sqb.Eq(sqb.Column(`ast.type`), sqb.Column(`at.id`)))).
+func (r *Repo) UpdateApplicationType(ctx context.Context, applType service.ApplicationType) error {
+	fieldsSchema, err := jsonObjectArg(applType.FieldsSchema)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE application_type
+	SET title = $1, fields_schema = $2, archived_at = $3
+	WHERE id = $4`
+
+	_, err = r.tx.ExecContext(ctx, query, applType.Title, fieldsSchema, applType.ArchivedAt, applType.ID)
+	if err != nil {
+		return err
+	}
+
+	return r.replaceTitles(ctx, applicationTypeTitles, applType.ID, applType.Titles)
+}
+
+// SetApplicationTypePositions orders the types as listed.
+func (r *Repo) SetApplicationTypePositions(ctx context.Context, ids []uuid.UUID) error {
+	query := `UPDATE application_type SET position = $1 WHERE id = $2`
+
+	for i, id := range ids {
+		_, err := r.tx.ExecContext(ctx, query, i, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var applicationSubTypeColumns = []sqb.Col{
+	sqb.Column(`ast.id`), sqb.Column(`ast.title`), sqb.Column(`ast.type`),
+	sqb.Column(`ast.response_minutes`), sqb.Column(`ast.resolution_minutes`),
+	sqb.Column(`ast.position`), sqb.Column(`ast.archived_at`),
+}
+
+func applicationSubTypeFields(subType *service.ApplicationSubType) []interface{} {
+	return []interface{}{
+		&subType.ID, &subType.Title, &subType.Type,
+		&subType.ResponseMinutes, &subType.ResolutionMinutes,
+		&subType.Position, &subType.ArchivedAt,
+	}
+}
+
+// CreateApplicationSubType adds the subtype after the existing subtypes of its type.
+func (r *Repo) CreateApplicationSubType(ctx context.Context, subType service.ApplicationSubType) error {
+	query := `INSERT INTO application_subtype (id, title, type, response_minutes, resolution_minutes, position)
+	VALUES ($1, $2, $3, $4, $5, (SELECT COALESCE(max(position) + 1, 0) FROM application_subtype WHERE type = $3))`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		subType.ID, subType.Title, subType.Type, subType.ResponseMinutes, subType.ResolutionMinutes)
+	if err != nil {
+		return err
+	}
+
+	return r.replaceTitles(ctx, applicationSubTypeTitles, subType.ID, subType.Titles)
+}
+
+func (r *Repo) GetApplicationSubType(ctx context.Context, id uuid.UUID) (*service.ApplicationSubType, error) {
+	query := sqb.From(sqb.TableName(`application_subtype`).As(`ast`)).
+		Select(applicationSubTypeColumns...).
+		Where(sqb.Eq(sqb.Column(`ast.id`), sqb.Arg{V: id}))
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, err
+	}
+
+	subType := &service.ApplicationSubType{}
+
+	err = r.tx.QueryRowContext(ctx, rawquery, args...).Scan(applicationSubTypeFields(subType)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	titles, err := r.listTitles(ctx, applicationSubTypeTitles, []uuid.UUID{subType.ID})
+	if err != nil {
+		return nil, err
+	}
+	subType.Titles = titles[subType.ID]
+
+	return subType, nil
+}
+
+func addApplicationSubTypeFilters(q *sqb.SelectStmt, filters service.ApplicationSubTypeFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	if filters.Type != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`ast.type`), sqb.Arg{V: *filters.Type}))...)
+	}
+
+	if filters.Search != "" {
+		query = query.Where(append(query.WhereStmt.Exprs,
+			sqb.BinaryOp(sqb.Column(`ast.title`), `ILIKE`, sqb.Arg{V: "%" + filters.Search + "%"}))...)
+	}
+
+	if !filters.IncludeArchived {
+		query = query.Where(append(query.WhereStmt.Exprs,
+			sqb.Raw(`ast.archived_at IS NULL`), sqb.Raw(`at.archived_at IS NULL`))...)
+	}
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByAsc(`at.position`)
+			filters.Pagination.AddOrderByAsc(`ast.position`)
+			filters.Pagination.AddOrderByAsc(`ast.title`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+// applicationSubTypeTable joins the subtype with its type, archived types hide their subtypes.
+var applicationSubTypeTable = sqb.JB(sqb.TableName(`application_subtype`).As(`ast`)).
+	InnerJoin(sqb.TableName(`application_type`).As(`at`), sqb.Eq(sqb.Column(`ast.type`), sqb.Column(`at.id`)))
+
+func (r *Repo) countApplicationSubTypes(ctx context.Context, filters service.ApplicationSubTypeFilter) (int, error) {
+	query := sqb.From(applicationSubTypeTable).
 		Select(sqb.Count(sqb.Column(`ast.id`)))
 
-	query = *addApplicationFilters(&query, filters, true)
+	query = *addApplicationSubTypeFilters(&query, filters, true)
 
 	rawquery, args, err := sqb.ToPostgreSql(query)
 	if err != nil {
 		return 0, err
 	}
 
-	fmt.Println(rawquery)
-
 	return count(ctx, r.tx, rawquery, args)
 }
 
-func (r *Repo) ListApplicationSubTypes(ctx context.Context, filters service.ApplicationFilter) ([]service.ApplicationSubType, int, error) {
+func (r *Repo) ListApplicationSubTypes(ctx context.Context, filters service.ApplicationSubTypeFilter) ([]service.ApplicationSubType, int, error) {
 	total, err := r.countApplicationSubTypes(ctx, filters)
 	if err != nil {
 		return nil, 0, err
@@ -290,20 +728,16 @@ func (r *Repo) ListApplicationSubTypes(ctx context.Context, filters service.Appl
 		return nil, 0, nil
 	}
 
-	query := sqb.From(
-		sqb.JB(sqb.TableName(`application_subtype`).As(`ast`)).
-			InnerJoin(sqb.TableName(`application_type`).As(`at`), sqb.Eq(sqb.Column(`ast.type`), sqb.Column(`at.id`)))).
-		Select(sqb.Column(`ast.id`), sqb.Column(`ast.title`), sqb.Column(`ast.type`))
+	query := sqb.From(applicationSubTypeTable).
+		Select(applicationSubTypeColumns...)
 
-	query = *addApplicationFilters(&query, filters, false)
+	query = *addApplicationSubTypeFilters(&query, filters, false)
 
 	rawquery, args, err := sqb.ToPostgreSql(query)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	fmt.Println(rawquery)
-
 	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
 	if err != nil {
 		return nil, 0, err
@@ -316,7 +750,7 @@ func (r *Repo) ListApplicationSubTypes(ctx context.Context, filters service.Appl
 	for rows.Next() {
 		addr := service.ApplicationSubType{}
 
-		err = rows.Scan(&addr.ID, &addr.Title, &addr.Type)
+		err = rows.Scan(applicationSubTypeFields(&addr)...)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -327,6 +761,49 @@ func (r *Repo) ListApplicationSubTypes(ctx context.Context, filters service.Appl
 	if err != nil {
 		return nil, 0, err
 	}
+	rows.Close()
+
+	subTypeIDs := make([]uuid.UUID, len(applicationsSubtypes))
+	for i := range applicationsSubtypes {
+		subTypeIDs[i] = applicationsSubtypes[i].ID
+	}
+
+	titles, err := r.listTitles(ctx, applicationSubTypeTitles, subTypeIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range applicationsSubtypes {
+		applicationsSubtypes[i].Titles = titles[applicationsSubtypes[i].ID]
+	}
 
 	return applicationsSubtypes, total, nil
 }
+
+func (r *Repo) UpdateApplicationSubType(ctx context.Context, subType service.ApplicationSubType) error {
+	query := `UPDATE application_subtype
+	SET title = $1, response_minutes = $2, resolution_minutes = $3, archived_at = $4
+	WHERE id = $5`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		subType.Title, subType.ResponseMinutes, subType.ResolutionMinutes, subType.ArchivedAt, subType.ID)
+	if err != nil {
+		return err
+	}
+
+	return r.replaceTitles(ctx, applicationSubTypeTitles, subType.ID, subType.Titles)
+}
+
+// SetApplicationSubTypePositions orders the subtypes as listed.
+func (r *Repo) SetApplicationSubTypePositions(ctx context.Context, ids []uuid.UUID) error {
+	query := `UPDATE application_subtype SET position = $1 WHERE id = $2`
+
+	for i, id := range ids {
+		_, err := r.tx.ExecContext(ctx, query, i, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
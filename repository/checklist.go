@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+func (r *Repo) ListSubTypeChecklist(ctx context.Context, subTypeID uuid.UUID) ([]service.ChecklistItem, error) {
+	query := `SELECT title, required
+	FROM subtype_checklist_item
+	WHERE subtype_id = $1
+	ORDER BY position`
+
+	rows, err := r.tx.QueryContext(ctx, query, subTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := []service.ChecklistItem{}
+
+	for rows.Next() {
+		item := service.ChecklistItem{}
+
+		err = rows.Scan(&item.Title, &item.Required)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *Repo) ReplaceSubTypeChecklist(ctx context.Context, subTypeID uuid.UUID, items []service.ChecklistItem) error {
+	_, err := r.tx.ExecContext(ctx, `DELETE FROM subtype_checklist_item WHERE subtype_id = $1`, subTypeID)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO subtype_checklist_item (subtype_id, position, title, required)
+	VALUES ($1, $2, $3, $4)`
+
+	for i, item := range items {
+		_, err = r.tx.ExecContext(ctx, query, subTypeID, i, item.Title, item.Required)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applicationChecklistItemFields(item *service.ApplicationChecklistItem) []interface{} {
+	return []interface{}{
+		&item.ID, &item.ApplicationID, &item.Position, &item.Title,
+		&item.Required, &item.DoneAt, &item.DoneBy,
+	}
+}
+
+func (r *Repo) CreateApplicationChecklist(ctx context.Context, items []service.ApplicationChecklistItem) error {
+	query := `INSERT INTO application_checklist_item (id, application_id, position, title, required)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	for _, item := range items {
+		_, err := r.tx.ExecContext(ctx, query, item.ID, item.ApplicationID, item.Position, item.Title, item.Required)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repo) GetApplicationChecklistItem(ctx context.Context, id uuid.UUID) (*service.ApplicationChecklistItem, error) {
+	query := `SELECT id, application_id, position, title, required, done_at, done_by
+	FROM application_checklist_item
+	WHERE id = $1`
+
+	item := &service.ApplicationChecklistItem{}
+
+	err := r.tx.QueryRowContext(ctx, query, id).Scan(applicationChecklistItemFields(item)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *Repo) ListApplicationChecklist(ctx context.Context, applicationID uuid.UUID) ([]service.ApplicationChecklistItem, error) {
+	query := `SELECT id, application_id, position, title, required, done_at, done_by
+	FROM application_checklist_item
+	WHERE application_id = $1
+	ORDER BY position`
+
+	rows, err := r.tx.QueryContext(ctx, query, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := []service.ApplicationChecklistItem{}
+
+	for rows.Next() {
+		item := service.ApplicationChecklistItem{}
+
+		err = rows.Scan(applicationChecklistItemFields(&item)...)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *Repo) UpdateApplicationChecklistItem(ctx context.Context, item service.ApplicationChecklistItem) error {
+	query := `UPDATE application_checklist_item
+	SET done_at = $1, done_by = $2
+	WHERE id = $3`
+
+	_, err := r.tx.ExecContext(ctx, query, item.DoneAt, item.DoneBy, item.ID)
+
+	return err
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vagruchi/sqb"
+)
+
+func (r *Repo) CreateApplicationComment(ctx context.Context, comment service.ApplicationComment) error {
+	query := `INSERT INTO application_comment (id, application_id, author_id, created_at, updated_at, text, internal)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		comment.ID, comment.ApplicationID, comment.AuthorID, comment.CreatedAt, comment.CreatedAt, comment.Text, comment.Internal)
+
+	return err
+}
+
+func (r *Repo) GetApplicationComment(ctx context.Context, id uuid.UUID) (*service.ApplicationComment, error) {
+	query := `SELECT id, application_id, author_id, created_at, updated_at, text, internal
+	FROM application_comment AS ac
+	WHERE ac.id = $1 AND ac.deleted_at IS NULL`
+
+	comment := &service.ApplicationComment{}
+
+	err := r.tx.QueryRowContext(ctx, query, id).
+		Scan(&comment.ID, &comment.ApplicationID, &comment.AuthorID, &comment.CreatedAt, &comment.UpdatedAt,
+			&comment.Text, &comment.Internal)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func addApplicationCommentFilters(q *sqb.SelectStmt, filters service.ApplicationCommentFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	query = query.Where(append(query.WhereStmt.Exprs, sqb.Raw(`ac.deleted_at IS NULL`))...)
+
+	query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`ac.application_id`), sqb.Arg{V: filters.ApplicationID}))...)
+
+	if !filters.WithInternal {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`ac.internal`), sqb.Arg{V: false}))...)
+	}
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByAsc(`ac.created_at`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+func (r *Repo) countApplicationComments(ctx context.Context, filters service.ApplicationCommentFilter) (int, error) {
+	query := sqb.From(sqb.TableName(`application_comment`).As(`ac`)).
+		Select(sqb.Count(sqb.Column(`ac.id`)))
+
+	query = *addApplicationCommentFilters(&query, filters, true)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return 0, err
+	}
+
+	return count(ctx, r.tx, rawquery, args)
+}
+
+func (r *Repo) ListApplicationComments(ctx context.Context, filters service.ApplicationCommentFilter) ([]service.ApplicationComment, int, error) {
+	total, err := r.countApplicationComments(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	query := sqb.From(sqb.TableName(`application_comment`).As(`ac`)).
+		Select(sqb.Column(`ac.id`), sqb.Column(`ac.application_id`), sqb.Column(`ac.author_id`), sqb.Column(`ac.created_at`),
+			sqb.Column(`ac.updated_at`), sqb.Column(`ac.text`), sqb.Column(`ac.internal`))
+
+	query = *addApplicationCommentFilters(&query, filters, false)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	comments := []service.ApplicationComment{}
+
+	for rows.Next() {
+		comment := service.ApplicationComment{}
+
+		err = rows.Scan(&comment.ID, &comment.ApplicationID, &comment.AuthorID, &comment.CreatedAt,
+			&comment.UpdatedAt, &comment.Text, &comment.Internal)
+		if err != nil {
+			return nil, 0, err
+		}
+		comments = append(comments, comment)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+func (r *Repo) UpdateApplicationComment(ctx context.Context, comment service.ApplicationComment) error {
+	query := `UPDATE application_comment
+	SET text = $1, internal = $2, updated_at = $3
+	WHERE id = $4`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		comment.Text, comment.Internal, comment.UpdatedAt, comment.ID)
+
+	return err
+}
+
+func (r *Repo) DeleteApplicationComment(ctx context.Context, id uuid.UUID, currentTime time.Time) error {
+	query := `UPDATE application_comment
+	SET deleted_at = $1
+	WHERE id = $2`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		currentTime, id)
+
+	return err
+}
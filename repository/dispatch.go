@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ListDispatchCandidates returns workers qualified for the application type and subtype
+// together with their current load. The field of the events is a literal, so the planner
+// uses the partial index application_event_performer_idx.
+func (r *Repo) ListDispatchCandidates(ctx context.Context, applType, applSubType uuid.UUID) ([]service.DispatchCandidate, error) {
+	query := `SELECT u.id,
+		(SELECT count(a.id) FROM application AS a
+			WHERE a.performer_id = u.id AND a.status IN ($3, $4, $5)) AS open_applications,
+		(SELECT max(ae.created_at) FROM application_event AS ae
+			WHERE ae.field = 'performer_id' AND ae.new_value = u.id::text) AS last_assigned_at
+	FROM users AS u
+	WHERE u.role = $6 AND u.deleted_at IS NULL
+		AND EXISTS (SELECT 1 FROM worker_qualification AS wq
+			WHERE wq.worker_id = u.id AND wq.type_id = $1 AND (wq.subtype_id IS NULL OR wq.subtype_id = $2))
+	ORDER BY u.id`
+
+	rows, err := r.tx.QueryContext(ctx, query, applType, applSubType,
+		service.ApplStatusCreated, service.ApplStatusInProgress, service.ApplStatusReopened,
+		service.UserRoleWorker)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	candidates := []service.DispatchCandidate{}
+
+	for rows.Next() {
+		candidate := service.DispatchCandidate{}
+
+		err = rows.Scan(&candidate.WorkerID, &candidate.OpenApplications, &candidate.LastAssignedAt)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+func (r *Repo) ListWorkerQualifications(ctx context.Context, workerID uuid.UUID) ([]service.WorkerQualification, error) {
+	query := `SELECT type_id, subtype_id
+	FROM worker_qualification
+	WHERE worker_id = $1
+	ORDER BY type_id, subtype_id NULLS FIRST`
+
+	rows, err := r.tx.QueryContext(ctx, query, workerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	qualifications := []service.WorkerQualification{}
+
+	for rows.Next() {
+		q := service.WorkerQualification{}
+
+		err = rows.Scan(&q.TypeID, &q.SubTypeID)
+		if err != nil {
+			return nil, err
+		}
+		qualifications = append(qualifications, q)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return qualifications, nil
+}
+
+func (r *Repo) ReplaceWorkerQualifications(ctx context.Context, workerID uuid.UUID, qualifications []service.WorkerQualification) error {
+	_, err := r.tx.ExecContext(ctx, `DELETE FROM worker_qualification WHERE worker_id = $1`, workerID)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO worker_qualification (worker_id, type_id, subtype_id) VALUES ($1, $2, $3)`
+
+	for _, q := range qualifications {
+		_, err = r.tx.ExecContext(ctx, query, workerID, q.TypeID, q.SubTypeID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"strconv"
+)
+
+// ListDuplicateCandidates returns open applications of the same type, subtype and premises
+// with a similar text, the most similar first. The % operator lets application_text_trgm_idx
+// find the similar texts, its threshold is set for the rest of the transaction.
+func (r *Repo) ListDuplicateCandidates(ctx context.Context, filter service.DuplicateFilter) ([]*service.Application, error) {
+	_, err := r.tx.ExecContext(ctx, `SELECT set_config('pg_trgm.similarity_threshold', $1, true)`,
+		strconv.FormatFloat(filter.MinSimilarity, 'f', -1, 64))
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + applicationColumnList() + `
+	FROM application AS a
+		LEFT JOIN building AS b ON a.building_id = b.id
+	WHERE a.id <> $1 AND a.type = $2 AND a.subtype = $3 AND a.created_at >= $4
+		AND a.status IN ($5, $6, $7) AND a.duplicate_of IS NULL
+		AND a.building_id IS NOT DISTINCT FROM $10 AND a.apartment_id IS NOT DISTINCT FROM $11
+		AND a.text % $8
+	ORDER BY similarity(a.text, $8) DESC, a.created_at DESC
+	LIMIT $9`
+
+	rows, err := r.tx.QueryContext(ctx, query, filter.ExcludeID, filter.Type, filter.SubType, filter.CreatedAfter,
+		service.ApplStatusCreated, service.ApplStatusInProgress, service.ApplStatusReopened,
+		filter.Text, filter.Limit, filter.BuildingID, filter.ApartmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applications := []*service.Application{}
+
+	for rows.Next() {
+		appl := &service.Application{}
+
+		err = rows.Scan(applicationFields(appl)...)
+		if err != nil {
+			return nil, err
+		}
+		applications = append(applications, appl)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	err = r.fillApplications(ctx, applications)
+	if err != nil {
+		return nil, err
+	}
+
+	return applications, nil
+}
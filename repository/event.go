@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+
+	"github.com/vagruchi/sqb"
+)
+
+func (r *Repo) CreateApplicationEvents(ctx context.Context, events []service.ApplicationEvent) error {
+	query := `INSERT INTO application_event (id, application_id, created_at, author_id, field, old_value, new_value, comment)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	for _, event := range events {
+		_, err := r.tx.ExecContext(ctx, query,
+			event.ID, event.ApplicationID, event.CreatedAt, event.AuthorID, event.Field, event.OldValue, event.NewValue, event.Comment)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addApplicationEventFilters(q *sqb.SelectStmt, filters service.ApplicationEventFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`ae.application_id`), sqb.Arg{V: filters.ApplicationID}))...)
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByAsc(`ae.created_at`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+func (r *Repo) countApplicationEvents(ctx context.Context, filters service.ApplicationEventFilter) (int, error) {
+	query := sqb.From(sqb.TableName(`application_event`).As(`ae`)).
+		Select(sqb.Count(sqb.Column(`ae.id`)))
+
+	query = *addApplicationEventFilters(&query, filters, true)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return 0, err
+	}
+
+	return count(ctx, r.tx, rawquery, args)
+}
+
+func (r *Repo) ListApplicationEvents(ctx context.Context, filters service.ApplicationEventFilter) ([]service.ApplicationEvent, int, error) {
+	total, err := r.countApplicationEvents(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	query := sqb.From(sqb.TableName(`application_event`).As(`ae`)).
+		Select(sqb.Column(`ae.id`), sqb.Column(`ae.application_id`), sqb.Column(`ae.created_at`), sqb.Column(`ae.author_id`),
+			sqb.Column(`ae.field`), sqb.Column(`ae.old_value`), sqb.Column(`ae.new_value`), sqb.Column(`ae.comment`))
+
+	query = *addApplicationEventFilters(&query, filters, false)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	events := []service.ApplicationEvent{}
+
+	for rows.Next() {
+		event := service.ApplicationEvent{}
+
+		err = rows.Scan(&event.ID, &event.ApplicationID, &event.CreatedAt, &event.AuthorID,
+			&event.Field, &event.OldValue, &event.NewValue, &event.Comment)
+		if err != nil {
+			return nil, 0, err
+		}
+		events = append(events, event)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/vagruchi/sqb"
+)
+
+// jsonObject scans a JSON object column, NULL leaves the map nil.
+type jsonObject struct {
+	object *map[string]interface{}
+}
+
+func scanJSONObject(object *map[string]interface{}) jsonObject {
+	return jsonObject{object: object}
+}
+
+func (o jsonObject) Scan(src interface{}) error {
+	var raw []byte
+
+	switch v := src.(type) {
+	case nil:
+		*o.object = nil
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("scan json object from %T", src)
+	}
+
+	*o.object = nil
+
+	return json.Unmarshal(raw, o.object)
+}
+
+// jsonObjectArg encodes the map to save it in a JSONB column, the nil map is saved as NULL.
+func jsonObjectArg(object map[string]interface{}) (interface{}, error) {
+	if object == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(raw), nil
+}
+
+// jsonbArg is the map passed as a jsonb argument.
+type jsonbArg map[string]interface{}
+
+func (q jsonbArg) WriteSQLTo(w sqb.SQLWriter) error {
+	raw, err := json.Marshal(map[string]interface{}(q))
+	if err != nil {
+		return err
+	}
+
+	_, err = w.WriteString(`CAST(`)
+	if err != nil {
+		return err
+	}
+
+	err = w.AddArgs(string(raw))
+	if err != nil {
+		return err
+	}
+
+	_, err = w.WriteString(` AS jsonb)`)
+	return err
+}
+
+func (jsonbArg) IsComparable() {}
+
+func (jsonbArg) IsCol() {}
+
+// fieldsMatch matches applications whose custom fields contain all the given values.
+func fieldsMatch(fields map[string]interface{}) sqb.BoolExpr {
+	return sqb.BinaryOp(sqb.Column(`a.fields`), `@>`, jsonbArg(fields))
+}
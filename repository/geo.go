@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"bio/pagination"
+	"bio/service"
+	"database/sql"
+	"strconv"
+
+	"github.com/vagruchi/sqb"
+)
+
+// ApplicationDistanceSortKey orders applications by the distance from the point of the filter,
+// it is ignored when the point is not set.
+const ApplicationDistanceSortKey = "distance"
+
+// earthRadius is the mean radius of the Earth in meters.
+const earthRadius = 6371000
+
+// distanceFrom is the haversine distance in meters between the building of the application and the point.
+// The argument of asin is clamped to 1, rounding makes it slightly greater for antipodal points.
+type distanceFrom service.GeoPoint
+
+func (p distanceFrom) WriteSQLTo(w sqb.SQLWriter) error {
+	parts := []interface{}{
+		`(2 * ` + strconv.Itoa(earthRadius) + ` * asin(LEAST(1, sqrt(power(sin(radians(b.latitude - `, p.Latitude,
+		`) / 2), 2) + cos(radians(`, p.Latitude,
+		`)) * cos(radians(b.latitude)) * power(sin(radians(b.longitude - `, p.Longitude,
+		`) / 2), 2)))))`,
+	}
+
+	for _, part := range parts {
+		var err error
+
+		if s, ok := part.(string); ok {
+			_, err = w.WriteString(s)
+		} else {
+			err = w.AddArgs(part)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (distanceFrom) IsComparable() {}
+func (distanceFrom) IsCol()        {}
+
+func withinRadius(p service.GeoPoint, radius float64) sqb.BoolExpr {
+	return sqb.BinaryOp(distanceFrom(p), `<=`, sqb.Arg{V: radius})
+}
+
+func withinBox(box service.GeoBox) []sqb.BoolExpr {
+	return []sqb.BoolExpr{
+		sqb.BinaryOp(sqb.Column(`b.latitude`), `>=`, sqb.Arg{V: box.SouthWest.Latitude}),
+		sqb.BinaryOp(sqb.Column(`b.latitude`), `<=`, sqb.Arg{V: box.NorthEast.Latitude}),
+		sqb.BinaryOp(sqb.Column(`b.longitude`), `>=`, sqb.Arg{V: box.SouthWest.Longitude}),
+		sqb.BinaryOp(sqb.Column(`b.longitude`), `<=`, sqb.Arg{V: box.NorthEast.Longitude}),
+	}
+}
+
+// withDistance replaces the distance sort key with the distance from the point.
+func withDistance(orderBy []*pagination.OrderBy, p *service.GeoPoint) []*pagination.OrderBy {
+	out := make([]*pagination.OrderBy, 0, len(orderBy))
+
+	for _, ob := range orderBy {
+		if ob.Sortname != ApplicationDistanceSortKey {
+			out = append(out, ob)
+			continue
+		}
+
+		if p == nil {
+			continue
+		}
+
+		ordered := *ob
+		ordered.Expr = distanceFrom(*p)
+		out = append(out, &ordered)
+	}
+
+	return out
+}
+
+// geoCoordinate scans the nullable latitude or longitude column into the location,
+// the location is left nil when the coordinates are not set.
+type geoCoordinate struct {
+	location  **service.GeoPoint
+	longitude bool
+}
+
+func scanLocation(location **service.GeoPoint) (latitude, longitude geoCoordinate) {
+	return geoCoordinate{location: location}, geoCoordinate{location: location, longitude: true}
+}
+
+func (c geoCoordinate) Scan(src interface{}) error {
+	v := sql.NullFloat64{}
+
+	err := v.Scan(src)
+	if err != nil || !v.Valid {
+		return err
+	}
+
+	if *c.location == nil {
+		*c.location = &service.GeoPoint{}
+	}
+
+	if c.longitude {
+		(*c.location).Longitude = v.Float64
+	} else {
+		(*c.location).Latitude = v.Float64
+	}
+
+	return nil
+}
+
+// locationArgs returns the latitude and the longitude to save, nil for the unknown location.
+func locationArgs(location *service.GeoPoint) (latitude, longitude *float64) {
+	if location == nil {
+		return nil, nil
+	}
+
+	return &location.Latitude, &location.Longitude
+}
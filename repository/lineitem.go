@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vagruchi/sqb"
+)
+
+// lineItemCost is the cost of the material of the line item li in kopecks, it matches ApplicationLineItem.Cost.
+const lineItemCost = `round(li.quantity * li.unit_price)::bigint`
+
+// applicationMaterialsCost and applicationLabourHours are the totals of the line items of the application a.
+const (
+	applicationMaterialsCost = `(SELECT coalesce(sum(` + lineItemCost + `), 0)::bigint
+		FROM application_line_item AS li WHERE li.application_id = a.id AND li.deleted_at IS NULL)`
+	applicationLabourHours = `(SELECT coalesce(sum(li.labour_hours), 0)::float8
+		FROM application_line_item AS li WHERE li.application_id = a.id AND li.deleted_at IS NULL)`
+)
+
+var lineItemColumns = []sqb.Col{
+	sqb.Column(`li.id`), sqb.Column(`li.application_id`), sqb.Column(`li.author_id`), sqb.Column(`li.created_at`),
+	sqb.Column(`li.updated_at`), sqb.Column(`li.material`), sqb.Column(`li.quantity::float8`),
+	sqb.Column(`li.unit_price`), sqb.Column(`li.labour_hours::float8`),
+}
+
+func lineItemFields(item *service.ApplicationLineItem) []interface{} {
+	return []interface{}{
+		&item.ID, &item.ApplicationID, &item.AuthorID, &item.CreatedAt,
+		&item.UpdatedAt, &item.Material, &item.Quantity,
+		&item.UnitPrice, &item.LabourHours,
+	}
+}
+
+func (r *Repo) CreateApplicationLineItem(ctx context.Context, item service.ApplicationLineItem) error {
+	query := `INSERT INTO application_line_item (id, application_id, author_id, created_at, updated_at,
+		material, quantity, unit_price, labour_hours)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		item.ID, item.ApplicationID, item.AuthorID, item.CreatedAt, item.UpdatedAt,
+		item.Material, item.Quantity, item.UnitPrice, item.LabourHours)
+
+	return err
+}
+
+func (r *Repo) GetApplicationLineItem(ctx context.Context, id uuid.UUID) (*service.ApplicationLineItem, error) {
+	query := `SELECT id, application_id, author_id, created_at, updated_at,
+		material, quantity::float8, unit_price, labour_hours::float8
+	FROM application_line_item AS li
+	WHERE li.id = $1 AND li.deleted_at IS NULL`
+
+	item := &service.ApplicationLineItem{}
+
+	err := r.tx.QueryRowContext(ctx, query, id).Scan(lineItemFields(item)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func addLineItemFilters(q *sqb.SelectStmt, filters service.ApplicationLineItemFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	query = query.Where(append(query.WhereStmt.Exprs,
+		sqb.Eq(sqb.Column(`li.application_id`), sqb.Arg{V: filters.ApplicationID}),
+		sqb.Raw(`li.deleted_at IS NULL`))...)
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByAsc(`li.created_at`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+func (r *Repo) countApplicationLineItems(ctx context.Context, filters service.ApplicationLineItemFilter) (int, error) {
+	query := sqb.From(sqb.TableName(`application_line_item`).As(`li`)).
+		Select(sqb.Count(sqb.Column(`li.id`)))
+
+	query = *addLineItemFilters(&query, filters, true)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return 0, err
+	}
+
+	return count(ctx, r.tx, rawquery, args)
+}
+
+func (r *Repo) ListApplicationLineItems(ctx context.Context, filters service.ApplicationLineItemFilter) ([]service.ApplicationLineItem, int, error) {
+	total, err := r.countApplicationLineItems(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	query := sqb.From(sqb.TableName(`application_line_item`).As(`li`)).
+		Select(lineItemColumns...)
+
+	query = *addLineItemFilters(&query, filters, false)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	items := []service.ApplicationLineItem{}
+
+	for rows.Next() {
+		item := service.ApplicationLineItem{}
+
+		err = rows.Scan(lineItemFields(&item)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		items = append(items, item)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
+
+func (r *Repo) UpdateApplicationLineItem(ctx context.Context, item service.ApplicationLineItem) error {
+	query := `UPDATE application_line_item
+	SET material = $1, quantity = $2, unit_price = $3, labour_hours = $4, updated_at = $5
+	WHERE id = $6`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		item.Material, item.Quantity, item.UnitPrice, item.LabourHours, item.UpdatedAt,
+		item.ID)
+
+	return err
+}
+
+func (r *Repo) DeleteApplicationLineItem(ctx context.Context, id uuid.UUID, currentTime time.Time) error {
+	query := `UPDATE application_line_item
+	SET deleted_at = $1
+	WHERE id = $2`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		currentTime, id)
+
+	return err
+}
+
+// ListApplicationTypeCosts sums the line items of applications by their types.
+func (r *Repo) ListApplicationTypeCosts(ctx context.Context) ([]service.CostStat, error) {
+	query := `SELECT a.type, count(DISTINCT a.id), sum(` + lineItemCost + `)::bigint, sum(li.labour_hours)::float8
+	FROM application AS a
+	JOIN application_line_item AS li ON li.application_id = a.id AND li.deleted_at IS NULL
+	GROUP BY a.type
+	ORDER BY a.type`
+
+	rows, err := r.tx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := []service.CostStat{}
+
+	for rows.Next() {
+		stat := service.CostStat{}
+
+		err = rows.Scan(&stat.ID, &stat.Count, &stat.MaterialsCost, &stat.LabourHours)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, stat)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vagruchi/sqb"
+)
+
+const maintenanceTemplateSelect = `SELECT mt.id, mt.created_at, mt.creator_id, mt.type, mt.subtype, mt.building_id,
+		mt.apartment_id, mt.text, mt.priority, mt.performer_id, mt.schedule, mt.active, mt.next_run_at, mt.last_run_at, mt.last_error
+	FROM maintenance_template AS mt`
+
+var maintenanceTemplateColumns = []sqb.Col{
+	sqb.Column(`mt.id`), sqb.Column(`mt.created_at`), sqb.Column(`mt.creator_id`), sqb.Column(`mt.type`),
+	sqb.Column(`mt.subtype`), sqb.Column(`mt.building_id`), sqb.Column(`mt.apartment_id`), sqb.Column(`mt.text`),
+	sqb.Column(`mt.priority`), sqb.Column(`mt.performer_id`), sqb.Column(`mt.schedule`), sqb.Column(`mt.active`),
+	sqb.Column(`mt.next_run_at`), sqb.Column(`mt.last_run_at`), sqb.Column(`mt.last_error`),
+}
+
+func maintenanceTemplateFields(tmpl *service.MaintenanceTemplate) []interface{} {
+	return []interface{}{
+		&tmpl.ID, &tmpl.CreatedAt, &tmpl.CreatorID, &tmpl.Type,
+		&tmpl.SubType, &tmpl.BuildingID, &tmpl.ApartmentID, &tmpl.Text,
+		&tmpl.Priority, &tmpl.PerformerID, &tmpl.Schedule, &tmpl.Active,
+		&tmpl.NextRunAt, &tmpl.LastRunAt, &tmpl.LastError,
+	}
+}
+
+func (r *Repo) CreateMaintenanceTemplate(ctx context.Context, tmpl service.MaintenanceTemplate) error {
+	query := `INSERT INTO maintenance_template (id, created_at, creator_id, type, subtype, building_id,
+		apartment_id, text, priority, performer_id, schedule, active, next_run_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		tmpl.ID, tmpl.CreatedAt, tmpl.CreatorID, tmpl.Type, tmpl.SubType, tmpl.BuildingID,
+		tmpl.ApartmentID, tmpl.Text, tmpl.Priority, tmpl.PerformerID, tmpl.Schedule, tmpl.Active, tmpl.NextRunAt)
+
+	return err
+}
+
+func (r *Repo) getMaintenanceTemplate(ctx context.Context, query string, args ...interface{}) (*service.MaintenanceTemplate, error) {
+	tmpl := &service.MaintenanceTemplate{}
+
+	err := r.tx.QueryRowContext(ctx, query, args...).Scan(maintenanceTemplateFields(tmpl)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+func (r *Repo) GetMaintenanceTemplate(ctx context.Context, id uuid.UUID) (*service.MaintenanceTemplate, error) {
+	query := maintenanceTemplateSelect + `
+	WHERE mt.id = $1 AND mt.deleted_at IS NULL`
+
+	return r.getMaintenanceTemplate(ctx, query, id)
+}
+
+func (r *Repo) ClaimDueMaintenanceTemplate(ctx context.Context, now time.Time) (*service.MaintenanceTemplate, error) {
+	query := maintenanceTemplateSelect + `
+	WHERE mt.active AND mt.deleted_at IS NULL AND mt.next_run_at <= $1
+	ORDER BY mt.next_run_at
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED`
+
+	return r.getMaintenanceTemplate(ctx, query, now)
+}
+
+func addMaintenanceTemplateFilters(q *sqb.SelectStmt, filters service.MaintenanceTemplateFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	query = query.Where(append(query.WhereStmt.Exprs, sqb.Raw(`mt.deleted_at IS NULL`))...)
+
+	if filters.BuildingID != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`mt.building_id`), sqb.Arg{V: *filters.BuildingID}))...)
+	}
+
+	if filters.Active != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`mt.active`), sqb.Arg{V: *filters.Active}))...)
+	}
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByDesc(`mt.created_at`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+func (r *Repo) countMaintenanceTemplates(ctx context.Context, filters service.MaintenanceTemplateFilter) (int, error) {
+	query := sqb.From(sqb.TableName(`maintenance_template`).As(`mt`)).
+		Select(sqb.Count(sqb.Column(`mt.id`)))
+
+	query = *addMaintenanceTemplateFilters(&query, filters, true)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return 0, err
+	}
+
+	return count(ctx, r.tx, rawquery, args)
+}
+
+func (r *Repo) ListMaintenanceTemplates(ctx context.Context, filters service.MaintenanceTemplateFilter) ([]service.MaintenanceTemplate, int, error) {
+	total, err := r.countMaintenanceTemplates(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	query := sqb.From(sqb.TableName(`maintenance_template`).As(`mt`)).
+		Select(maintenanceTemplateColumns...)
+
+	query = *addMaintenanceTemplateFilters(&query, filters, false)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	templates := []service.MaintenanceTemplate{}
+
+	for rows.Next() {
+		tmpl := service.MaintenanceTemplate{}
+
+		err = rows.Scan(maintenanceTemplateFields(&tmpl)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		templates = append(templates, tmpl)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return templates, total, nil
+}
+
+func (r *Repo) UpdateMaintenanceTemplate(ctx context.Context, tmpl service.MaintenanceTemplate) error {
+	query := `UPDATE maintenance_template
+	SET text = $1, priority = $2, performer_id = $3, schedule = $4, active = $5, next_run_at = $6, last_run_at = $7,
+		last_error = $8
+	WHERE id = $9`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		tmpl.Text, tmpl.Priority, tmpl.PerformerID, tmpl.Schedule, tmpl.Active, tmpl.NextRunAt, tmpl.LastRunAt,
+		tmpl.LastError, tmpl.ID)
+
+	return err
+}
+
+func (r *Repo) DeleteMaintenanceTemplate(ctx context.Context, id uuid.UUID, currentTime time.Time) error {
+	query := `UPDATE maintenance_template
+	SET deleted_at = $1, active = FALSE, next_run_at = NULL
+	WHERE id = $2`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		currentTime, id)
+
+	return err
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (r *Repo) createApplicationPhotos(ctx context.Context, applicationID uuid.UUID, photoIDs []uuid.UUID) error {
+	query := `INSERT INTO application_photo (application_id, photo_id, created_at)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (application_id, photo_id) DO NOTHING`
+
+	createdAt := time.Now().UTC()
+
+	for _, photoID := range photoIDs {
+		_, err := r.tx.ExecContext(ctx, query, applicationID, photoID, createdAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repo) deleteApplicationPhotos(ctx context.Context, applicationID uuid.UUID, photoIDs []uuid.UUID) error {
+	query := `DELETE FROM application_photo
+	WHERE application_id = $1 AND photo_id = ANY($2::uuid[])`
+
+	_, err := r.tx.ExecContext(ctx, query, applicationID, uuidsToStrings(photoIDs))
+
+	return err
+}
+
+// listApplicationPhotos loads photos of several applications with a single query.
+func (r *Repo) listApplicationPhotos(ctx context.Context, applicationIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	query := `SELECT application_id, photo_id
+	FROM application_photo
+	WHERE application_id = ANY($1::uuid[])
+	ORDER BY created_at, photo_id`
+
+	rows, err := r.tx.QueryContext(ctx, query, uuidsToStrings(applicationIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	photos := map[uuid.UUID][]uuid.UUID{}
+
+	for rows.Next() {
+		var applicationID, photoID uuid.UUID
+
+		err = rows.Scan(&applicationID, &photoID)
+		if err != nil {
+			return nil, err
+		}
+		photos[applicationID] = append(photos[applicationID], photoID)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return photos, nil
+}
+
+func uuidsToStrings(ids []uuid.UUID) []string {
+	out := make([]string, len(ids))
+	for i := range ids {
+		out[i] = ids[i].String()
+	}
+
+	return out
+}
+
+func (r *Repo) CreatePhoto(ctx context.Context, photo service.Photo) error {
+	query := `INSERT INTO photo (id, created_at, creator_id, content_type, size)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		photo.ID, photo.CreatedAt, photo.CreatorID, photo.ContentType, photo.Size)
+
+	return err
+}
+
+func (r *Repo) GetPhoto(ctx context.Context, id uuid.UUID) (*service.Photo, error) {
+	query := `SELECT id, created_at, creator_id, content_type, size
+	FROM photo AS p
+	WHERE p.id = $1`
+
+	photo := &service.Photo{}
+
+	err := r.tx.QueryRowContext(ctx, query, id).
+		Scan(&photo.ID, &photo.CreatedAt, &photo.CreatorID, &photo.ContentType, &photo.Size)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return photo, nil
+}
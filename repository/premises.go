@@ -0,0 +1,415 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vagruchi/sqb"
+)
+
+func (r *Repo) CreateBuilding(ctx context.Context, building service.Building) error {
+	query := `INSERT INTO building (id, created_at, address, latitude, longitude)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	latitude, longitude := locationArgs(building.Location)
+
+	_, err := r.tx.ExecContext(ctx, query,
+		building.ID, building.CreatedAt, building.Address, latitude, longitude)
+
+	return err
+}
+
+func (r *Repo) GetBuilding(ctx context.Context, id uuid.UUID) (*service.Building, error) {
+	query := `SELECT id, created_at, address, latitude, longitude
+	FROM building AS b
+	WHERE b.id = $1 AND b.deleted_at IS NULL`
+
+	building := &service.Building{}
+	latitude, longitude := scanLocation(&building.Location)
+
+	err := r.tx.QueryRowContext(ctx, query, id).
+		Scan(&building.ID, &building.CreatedAt, &building.Address, latitude, longitude)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return building, nil
+}
+
+func addBuildingFilters(q *sqb.SelectStmt, filters service.BuildingFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	query = query.Where(append(query.WhereStmt.Exprs, sqb.Raw(`b.deleted_at IS NULL`))...)
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByAsc(`b.address`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+func (r *Repo) countBuildings(ctx context.Context, filters service.BuildingFilter) (int, error) {
+	query := sqb.From(sqb.TableName(`building`).As(`b`)).
+		Select(sqb.Count(sqb.Column(`b.id`)))
+
+	query = *addBuildingFilters(&query, filters, true)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return 0, err
+	}
+
+	return count(ctx, r.tx, rawquery, args)
+}
+
+func (r *Repo) ListBuildings(ctx context.Context, filters service.BuildingFilter) ([]service.Building, int, error) {
+	total, err := r.countBuildings(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	query := sqb.From(sqb.TableName(`building`).As(`b`)).
+		Select(sqb.Column(`b.id`), sqb.Column(`b.created_at`), sqb.Column(`b.address`), sqb.Column(`b.latitude`),
+			sqb.Column(`b.longitude`))
+
+	query = *addBuildingFilters(&query, filters, false)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	buildings := []service.Building{}
+
+	for rows.Next() {
+		building := service.Building{}
+		latitude, longitude := scanLocation(&building.Location)
+
+		err = rows.Scan(&building.ID, &building.CreatedAt, &building.Address, latitude, longitude)
+		if err != nil {
+			return nil, 0, err
+		}
+		buildings = append(buildings, building)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return buildings, total, nil
+}
+
+func (r *Repo) UpdateBuilding(ctx context.Context, building service.Building) error {
+	query := `UPDATE building
+	SET address = $1, latitude = $2, longitude = $3
+	WHERE id = $4`
+
+	latitude, longitude := locationArgs(building.Location)
+
+	_, err := r.tx.ExecContext(ctx, query,
+		building.Address, latitude, longitude, building.ID)
+
+	return err
+}
+
+// DeleteBuilding removes the building together with its entrances and apartments.
+func (r *Repo) DeleteBuilding(ctx context.Context, id uuid.UUID, currentTime time.Time) error {
+	for _, query := range []string{
+		`UPDATE apartment SET deleted_at = $1 WHERE building_id = $2 AND deleted_at IS NULL`,
+		`UPDATE entrance SET deleted_at = $1 WHERE building_id = $2 AND deleted_at IS NULL`,
+		`UPDATE building SET deleted_at = $1 WHERE id = $2`,
+	} {
+		_, err := r.tx.ExecContext(ctx, query, currentTime, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repo) CreateEntrance(ctx context.Context, entrance service.Entrance) error {
+	query := `INSERT INTO entrance (id, building_id, number)
+	VALUES ($1, $2, $3)`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		entrance.ID, entrance.BuildingID, entrance.Number)
+
+	return err
+}
+
+func (r *Repo) GetEntrance(ctx context.Context, id uuid.UUID) (*service.Entrance, error) {
+	query := `SELECT id, building_id, number
+	FROM entrance AS e
+	WHERE e.id = $1 AND e.deleted_at IS NULL`
+
+	entrance := &service.Entrance{}
+
+	err := r.tx.QueryRowContext(ctx, query, id).
+		Scan(&entrance.ID, &entrance.BuildingID, &entrance.Number)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return entrance, nil
+}
+
+func addEntranceFilters(q *sqb.SelectStmt, filters service.EntranceFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	query = query.Where(append(query.WhereStmt.Exprs, sqb.Raw(`e.deleted_at IS NULL`))...)
+
+	query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`e.building_id`), sqb.Arg{V: filters.BuildingID}))...)
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByAsc(`e.number`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+func (r *Repo) countEntrances(ctx context.Context, filters service.EntranceFilter) (int, error) {
+	query := sqb.From(sqb.TableName(`entrance`).As(`e`)).
+		Select(sqb.Count(sqb.Column(`e.id`)))
+
+	query = *addEntranceFilters(&query, filters, true)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return 0, err
+	}
+
+	return count(ctx, r.tx, rawquery, args)
+}
+
+func (r *Repo) ListEntrances(ctx context.Context, filters service.EntranceFilter) ([]service.Entrance, int, error) {
+	total, err := r.countEntrances(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	query := sqb.From(sqb.TableName(`entrance`).As(`e`)).
+		Select(sqb.Column(`e.id`), sqb.Column(`e.building_id`), sqb.Column(`e.number`))
+
+	query = *addEntranceFilters(&query, filters, false)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	entrances := []service.Entrance{}
+
+	for rows.Next() {
+		entrance := service.Entrance{}
+
+		err = rows.Scan(&entrance.ID, &entrance.BuildingID, &entrance.Number)
+		if err != nil {
+			return nil, 0, err
+		}
+		entrances = append(entrances, entrance)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entrances, total, nil
+}
+
+func (r *Repo) UpdateEntrance(ctx context.Context, entrance service.Entrance) error {
+	query := `UPDATE entrance
+	SET number = $1
+	WHERE id = $2`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		entrance.Number, entrance.ID)
+
+	return err
+}
+
+// DeleteEntrance removes the entrance, its apartments stay in the building without an entrance.
+func (r *Repo) DeleteEntrance(ctx context.Context, id uuid.UUID, currentTime time.Time) error {
+	_, err := r.tx.ExecContext(ctx, `UPDATE apartment SET entrance_id = NULL WHERE entrance_id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.tx.ExecContext(ctx, `UPDATE entrance SET deleted_at = $1 WHERE id = $2`, currentTime, id)
+
+	return err
+}
+
+func (r *Repo) CreateApartment(ctx context.Context, apartment service.Apartment) error {
+	query := `INSERT INTO apartment (id, building_id, entrance_id, number, floor)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		apartment.ID, apartment.BuildingID, apartment.EntranceID, apartment.Number, apartment.Floor)
+
+	return err
+}
+
+func (r *Repo) GetApartment(ctx context.Context, id uuid.UUID) (*service.Apartment, error) {
+	query := `SELECT id, building_id, entrance_id, number, floor
+	FROM apartment AS ap
+	WHERE ap.id = $1 AND ap.deleted_at IS NULL`
+
+	apartment := &service.Apartment{}
+
+	err := r.tx.QueryRowContext(ctx, query, id).
+		Scan(&apartment.ID, &apartment.BuildingID, &apartment.EntranceID, &apartment.Number, &apartment.Floor)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return apartment, nil
+}
+
+func addApartmentFilters(q *sqb.SelectStmt, filters service.ApartmentFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	query = query.Where(append(query.WhereStmt.Exprs, sqb.Raw(`ap.deleted_at IS NULL`))...)
+
+	query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`ap.building_id`), sqb.Arg{V: filters.BuildingID}))...)
+
+	if filters.EntranceID != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`ap.entrance_id`), sqb.Arg{V: *filters.EntranceID}))...)
+	}
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByAsc(`ap.number`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+func (r *Repo) countApartments(ctx context.Context, filters service.ApartmentFilter) (int, error) {
+	query := sqb.From(sqb.TableName(`apartment`).As(`ap`)).
+		Select(sqb.Count(sqb.Column(`ap.id`)))
+
+	query = *addApartmentFilters(&query, filters, true)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return 0, err
+	}
+
+	return count(ctx, r.tx, rawquery, args)
+}
+
+func (r *Repo) ListApartments(ctx context.Context, filters service.ApartmentFilter) ([]service.Apartment, int, error) {
+	total, err := r.countApartments(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	query := sqb.From(sqb.TableName(`apartment`).As(`ap`)).
+		Select(sqb.Column(`ap.id`), sqb.Column(`ap.building_id`), sqb.Column(`ap.entrance_id`), sqb.Column(`ap.number`),
+			sqb.Column(`ap.floor`))
+
+	query = *addApartmentFilters(&query, filters, false)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	apartments := []service.Apartment{}
+
+	for rows.Next() {
+		apartment := service.Apartment{}
+
+		err = rows.Scan(&apartment.ID, &apartment.BuildingID, &apartment.EntranceID, &apartment.Number, &apartment.Floor)
+		if err != nil {
+			return nil, 0, err
+		}
+		apartments = append(apartments, apartment)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return apartments, total, nil
+}
+
+func (r *Repo) UpdateApartment(ctx context.Context, apartment service.Apartment) error {
+	query := `UPDATE apartment
+	SET entrance_id = $1, number = $2, floor = $3
+	WHERE id = $4`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		apartment.EntranceID, apartment.Number, apartment.Floor, apartment.ID)
+
+	return err
+}
+
+func (r *Repo) DeleteApartment(ctx context.Context, id uuid.UUID, currentTime time.Time) error {
+	query := `UPDATE apartment
+	SET deleted_at = $1
+	WHERE id = $2`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		currentTime, id)
+
+	return err
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (r *Repo) RateApplication(ctx context.Context, id uuid.UUID, rating int, review string, ratedAt time.Time) error {
+	query := `UPDATE application
+	SET rating = $1, review = $2, rated_at = $3
+	WHERE id = $4`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		rating, review, ratedAt, id)
+
+	return err
+}
+
+func (r *Repo) ListWorkerRatings(ctx context.Context) ([]service.RatingStat, error) {
+	query := `SELECT a.performer_id, count(a.rating), avg(a.rating)::float8
+	FROM application AS a
+	WHERE a.rating IS NOT NULL AND a.performer_id IS NOT NULL
+	GROUP BY a.performer_id
+	ORDER BY a.performer_id`
+
+	return r.listRatings(ctx, query)
+}
+
+func (r *Repo) ListApplicationTypeRatings(ctx context.Context) ([]service.RatingStat, error) {
+	query := `SELECT a.type, count(a.rating), avg(a.rating)::float8
+	FROM application AS a
+	WHERE a.rating IS NOT NULL
+	GROUP BY a.type
+	ORDER BY a.type`
+
+	return r.listRatings(ctx, query)
+}
+
+func (r *Repo) listRatings(ctx context.Context, query string, args ...interface{}) ([]service.RatingStat, error) {
+	rows, err := r.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := []service.RatingStat{}
+
+	for rows.Next() {
+		stat := service.RatingStat{}
+
+		err = rows.Scan(&stat.ID, &stat.Count, &stat.Average)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, stat)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vagruchi/sqb"
+)
+
+// dateArg passes the calendar day of the time to a DATE column regardless of the session time zone.
+func dateArg(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+func (r *Repo) ListWorkingHours(ctx context.Context, workerID uuid.UUID) ([]service.WorkingHours, error) {
+	query := `SELECT weekday, start_minute, end_minute
+	FROM worker_hours
+	WHERE worker_id = $1
+	ORDER BY weekday, start_minute`
+
+	rows, err := r.tx.QueryContext(ctx, query, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	hours := []service.WorkingHours{}
+
+	for rows.Next() {
+		h := service.WorkingHours{}
+
+		err = rows.Scan(&h.Weekday, &h.StartMinute, &h.EndMinute)
+		if err != nil {
+			return nil, err
+		}
+		hours = append(hours, h)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return hours, nil
+}
+
+func (r *Repo) ReplaceWorkingHours(ctx context.Context, workerID uuid.UUID, hours []service.WorkingHours) error {
+	_, err := r.tx.ExecContext(ctx, `DELETE FROM worker_hours WHERE worker_id = $1`, workerID)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO worker_hours (worker_id, weekday, start_minute, end_minute)
+	VALUES ($1, $2, $3, $4)`
+
+	for _, h := range hours {
+		_, err = r.tx.ExecContext(ctx, query, workerID, int(h.Weekday), h.StartMinute, h.EndMinute)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var workerAbsenceColumns = []sqb.Col{
+	sqb.Column(`wa.id`), sqb.Column(`wa.worker_id`), sqb.Column(`wa.created_at`), sqb.Column(`wa.starts_on`),
+	sqb.Column(`wa.ends_on`), sqb.Column(`wa.reason`), sqb.Column(`wa.comment`),
+}
+
+func workerAbsenceFields(absence *service.WorkerAbsence) []interface{} {
+	return []interface{}{
+		&absence.ID, &absence.WorkerID, &absence.CreatedAt, &absence.StartsOn,
+		&absence.EndsOn, &absence.Reason, &absence.Comment,
+	}
+}
+
+func (r *Repo) CreateWorkerAbsence(ctx context.Context, absence service.WorkerAbsence) error {
+	query := `INSERT INTO worker_absence (id, worker_id, created_at, starts_on, ends_on, reason, comment)
+	VALUES ($1, $2, $3, $4::date, $5::date, $6, $7)`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		absence.ID, absence.WorkerID, absence.CreatedAt, dateArg(absence.StartsOn),
+		dateArg(absence.EndsOn), absence.Reason, absence.Comment)
+
+	return err
+}
+
+func (r *Repo) GetWorkerAbsence(ctx context.Context, id uuid.UUID) (*service.WorkerAbsence, error) {
+	query := `SELECT id, worker_id, created_at, starts_on, ends_on, reason, comment
+	FROM worker_absence AS wa
+	WHERE wa.id = $1 AND wa.deleted_at IS NULL`
+
+	absence := &service.WorkerAbsence{}
+
+	err := r.tx.QueryRowContext(ctx, query, id).Scan(workerAbsenceFields(absence)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return absence, nil
+}
+
+func (r *Repo) FindWorkerAbsence(ctx context.Context, workerID uuid.UUID, day time.Time) (*service.WorkerAbsence, error) {
+	query := `SELECT id, worker_id, created_at, starts_on, ends_on, reason, comment
+	FROM worker_absence AS wa
+	WHERE wa.worker_id = $1 AND wa.deleted_at IS NULL AND $2::date BETWEEN wa.starts_on AND wa.ends_on
+	ORDER BY wa.ends_on DESC
+	LIMIT 1`
+
+	absence := &service.WorkerAbsence{}
+
+	err := r.tx.QueryRowContext(ctx, query, workerID, dateArg(day)).Scan(workerAbsenceFields(absence)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return absence, nil
+}
+
+func addWorkerAbsenceFilters(q *sqb.SelectStmt, filters service.WorkerAbsenceFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	query = query.Where(append(query.WhereStmt.Exprs,
+		sqb.Eq(sqb.Column(`wa.worker_id`), sqb.Arg{V: filters.WorkerID}),
+		sqb.Raw(`wa.deleted_at IS NULL`))...)
+
+	if filters.From != nil {
+		query = query.Where(append(query.WhereStmt.Exprs,
+			sqb.BinaryOp(sqb.Column(`wa.ends_on`), `>=`, sqb.Arg{V: dateArg(*filters.From)}))...)
+	}
+
+	if filters.To != nil {
+		query = query.Where(append(query.WhereStmt.Exprs,
+			sqb.BinaryOp(sqb.Column(`wa.starts_on`), `<=`, sqb.Arg{V: dateArg(*filters.To)}))...)
+	}
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByAsc(`wa.starts_on`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+func (r *Repo) countWorkerAbsences(ctx context.Context, filters service.WorkerAbsenceFilter) (int, error) {
+	query := sqb.From(sqb.TableName(`worker_absence`).As(`wa`)).
+		Select(sqb.Count(sqb.Column(`wa.id`)))
+
+	query = *addWorkerAbsenceFilters(&query, filters, true)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return 0, err
+	}
+
+	return count(ctx, r.tx, rawquery, args)
+}
+
+func (r *Repo) ListWorkerAbsences(ctx context.Context, filters service.WorkerAbsenceFilter) ([]service.WorkerAbsence, int, error) {
+	total, err := r.countWorkerAbsences(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	query := sqb.From(sqb.TableName(`worker_absence`).As(`wa`)).
+		Select(workerAbsenceColumns...)
+
+	query = *addWorkerAbsenceFilters(&query, filters, false)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	absences := []service.WorkerAbsence{}
+
+	for rows.Next() {
+		absence := service.WorkerAbsence{}
+
+		err = rows.Scan(workerAbsenceFields(&absence)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		absences = append(absences, absence)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return absences, total, nil
+}
+
+func (r *Repo) DeleteWorkerAbsence(ctx context.Context, id uuid.UUID, currentTime time.Time) error {
+	query := `UPDATE worker_absence
+	SET deleted_at = $1
+	WHERE id = $2`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		currentTime, id)
+
+	return err
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"bio/pagination"
+
+	"github.com/vagruchi/sqb"
+)
+
+// ApplicationRelevanceSortKey orders applications by the rank of the search query,
+// it is ignored when the query is empty.
+const ApplicationRelevanceSortKey = "relevance"
+
+// searchConfig is the text search configuration of application.search_vector.
+const searchConfig = "russian"
+
+// searchQuery is the user search query converted to tsquery.
+type searchQuery string
+
+func (q searchQuery) WriteSQLTo(w sqb.SQLWriter) error {
+	_, err := w.WriteString(`plainto_tsquery('` + searchConfig + `', `)
+	if err != nil {
+		return err
+	}
+
+	err = w.AddArgs(string(q))
+	if err != nil {
+		return err
+	}
+
+	_, err = w.WriteString(`)`)
+	return err
+}
+
+func (searchQuery) IsComparable() {}
+
+// searchRank is the relevance of the application to the search query.
+type searchRank string
+
+func (r searchRank) WriteSQLTo(w sqb.SQLWriter) error {
+	_, err := w.WriteString(`ts_rank(a.search_vector, `)
+	if err != nil {
+		return err
+	}
+
+	err = searchQuery(r).WriteSQLTo(w)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.WriteString(`)`)
+	return err
+}
+
+func (searchRank) IsCol() {}
+
+func searchMatch(q string) sqb.BoolExpr {
+	return sqb.BinaryOp(sqb.Column(`a.search_vector`), `@@`, searchQuery(q))
+}
+
+// withSearchRank replaces the relevance sort key with the rank of the query.
+func withSearchRank(orderBy []*pagination.OrderBy, q string) []*pagination.OrderBy {
+	out := make([]*pagination.OrderBy, 0, len(orderBy))
+
+	for _, ob := range orderBy {
+		if ob.Sortname != ApplicationRelevanceSortKey {
+			out = append(out, ob)
+			continue
+		}
+
+		if q == "" {
+			continue
+		}
+
+		ranked := *ob
+		ranked.Expr = searchRank(q)
+		out = append(out, &ranked)
+	}
+
+	return out
+}
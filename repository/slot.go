@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"bio/service"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vagruchi/sqb"
+)
+
+var visitSlotColumns = []sqb.Col{
+	sqb.Column(`vs.id`), sqb.Column(`vs.worker_id`), sqb.Column(`vs.created_at`), sqb.Column(`vs.starts_at`),
+	sqb.Column(`vs.ends_at`), sqb.Column(`vs.application_id`), sqb.Column(`vs.booked_at`),
+}
+
+func visitSlotFields(slot *service.VisitSlot) []interface{} {
+	return []interface{}{
+		&slot.ID, &slot.WorkerID, &slot.CreatedAt, &slot.StartsAt,
+		&slot.EndsAt, &slot.ApplicationID, &slot.BookedAt,
+	}
+}
+
+func (r *Repo) CreateVisitSlot(ctx context.Context, slot service.VisitSlot) error {
+	query := `INSERT INTO visit_slot (id, worker_id, created_at, starts_at, ends_at)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.tx.ExecContext(ctx, query,
+		slot.ID, slot.WorkerID, slot.CreatedAt, slot.StartsAt, slot.EndsAt)
+
+	return err
+}
+
+func (r *Repo) GetVisitSlot(ctx context.Context, id uuid.UUID) (*service.VisitSlot, error) {
+	query := `SELECT id, worker_id, created_at, starts_at, ends_at, application_id, booked_at
+	FROM visit_slot AS vs
+	WHERE vs.id = $1`
+
+	slot := &service.VisitSlot{}
+
+	err := r.tx.QueryRowContext(ctx, query, id).Scan(visitSlotFields(slot)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return slot, nil
+}
+
+func addVisitSlotFilters(q *sqb.SelectStmt, filters service.VisitSlotFilter, isCount bool) *sqb.SelectStmt {
+	query := *q
+
+	query = query.Where(append(query.WhereStmt.Exprs, sqb.Eq(sqb.Column(`vs.worker_id`), sqb.Arg{V: filters.WorkerID}))...)
+
+	if filters.From != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.BinaryOp(sqb.Column(`vs.ends_at`), `>`, sqb.Arg{V: *filters.From}))...)
+	}
+
+	if filters.To != nil {
+		query = query.Where(append(query.WhereStmt.Exprs, sqb.BinaryOp(sqb.Column(`vs.starts_at`), `<`, sqb.Arg{V: *filters.To}))...)
+	}
+
+	if filters.Available != nil {
+		booked := sqb.Raw(`vs.application_id IS NOT NULL`)
+		if *filters.Available {
+			booked = sqb.Raw(`vs.application_id IS NULL`)
+		}
+		query = query.Where(append(query.WhereStmt.Exprs, booked)...)
+	}
+
+	if !isCount {
+		if len(filters.Pagination.OrderBy) == 0 {
+			filters.Pagination.AddOrderByAsc(`vs.starts_at`)
+		}
+		query = *filters.Pagination.Apply(&query)
+	}
+
+	return &query
+}
+
+func (r *Repo) countVisitSlots(ctx context.Context, filters service.VisitSlotFilter) (int, error) {
+	query := sqb.From(sqb.TableName(`visit_slot`).As(`vs`)).
+		Select(sqb.Count(sqb.Column(`vs.id`)))
+
+	query = *addVisitSlotFilters(&query, filters, true)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return 0, err
+	}
+
+	return count(ctx, r.tx, rawquery, args)
+}
+
+func (r *Repo) ListVisitSlots(ctx context.Context, filters service.VisitSlotFilter) ([]service.VisitSlot, int, error) {
+	total, err := r.countVisitSlots(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	query := sqb.From(sqb.TableName(`visit_slot`).As(`vs`)).
+		Select(visitSlotColumns...)
+
+	query = *addVisitSlotFilters(&query, filters, false)
+
+	rawquery, args, err := sqb.ToPostgreSql(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.tx.QueryContext(ctx, rawquery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	slots := []service.VisitSlot{}
+
+	for rows.Next() {
+		slot := service.VisitSlot{}
+
+		err = rows.Scan(visitSlotFields(&slot)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		slots = append(slots, slot)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return slots, total, nil
+}
+
+func (r *Repo) DeleteVisitSlot(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM visit_slot
+	WHERE id = $1 AND application_id IS NULL`
+
+	_, err := r.tx.ExecContext(ctx, query, id)
+
+	return err
+}
+
+func (r *Repo) HasOverlappingVisitSlot(ctx context.Context, slot service.VisitSlot, bookedOnly bool) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM visit_slot AS vs
+		WHERE vs.worker_id = $1 AND vs.id <> $2 AND vs.starts_at < $4 AND vs.ends_at > $3
+			AND (NOT $5 OR vs.application_id IS NOT NULL))`
+
+	var overlaps bool
+
+	err := r.tx.QueryRowContext(ctx, query, slot.WorkerID, slot.ID, slot.StartsAt, slot.EndsAt, bookedOnly).
+		Scan(&overlaps)
+
+	return overlaps, err
+}
+
+// BookVisitSlot links the free slot to the application, the slot booked meanwhile is a conflict.
+func (r *Repo) BookVisitSlot(ctx context.Context, id, applicationID uuid.UUID, bookedAt time.Time) error {
+	query := `UPDATE visit_slot
+	SET application_id = $1, booked_at = $2
+	WHERE id = $3 AND application_id IS NULL`
+
+	res, err := r.tx.ExecContext(ctx, query, applicationID, bookedAt, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return service.ErrSlotConflict
+	}
+
+	return nil
+}
+
+func (r *Repo) ReleaseVisitSlots(ctx context.Context, applicationID uuid.UUID) error {
+	query := `UPDATE visit_slot
+	SET application_id = NULL, booked_at = NULL
+	WHERE application_id = $1`
+
+	_, err := r.tx.ExecContext(ctx, query, applicationID)
+
+	return err
+}
+
+func (r *Repo) ReleaseVisitSlotsFrom(ctx context.Context, applicationID uuid.UUID, from time.Time) error {
+	query := `UPDATE visit_slot
+	SET application_id = NULL, booked_at = NULL
+	WHERE application_id = $1 AND starts_at >= $2`
+
+	_, err := r.tx.ExecContext(ctx, query, applicationID, from)
+
+	return err
+}
+
+func (r *Repo) LockWorker(ctx context.Context, workerID uuid.UUID) error {
+	query := `SELECT id FROM users WHERE id = $1 FOR UPDATE`
+
+	var id uuid.UUID
+
+	err := r.tx.QueryRowContext(ctx, query, workerID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return service.ErrNotFound
+	}
+
+	return err
+}
+
+// listApplicationVisitSlots returns the booked slots of the applications.
+func (r *Repo) listApplicationVisitSlots(ctx context.Context, applicationIDs []uuid.UUID) (map[uuid.UUID]*service.VisitSlot, error) {
+	query := `SELECT id, worker_id, created_at, starts_at, ends_at, application_id, booked_at
+	FROM visit_slot AS vs
+	WHERE vs.application_id = ANY($1::uuid[])`
+
+	rows, err := r.tx.QueryContext(ctx, query, uuidsToStrings(applicationIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	slots := map[uuid.UUID]*service.VisitSlot{}
+
+	for rows.Next() {
+		slot := &service.VisitSlot{}
+
+		err = rows.Scan(visitSlotFields(slot)...)
+		if err != nil {
+			return nil, err
+		}
+		slots[*slot.ApplicationID] = slot
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return slots, nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// titleTable is a table of translated titles of a catalog, like application_type_title.
+type titleTable struct {
+	name   string
+	column string
+}
+
+var (
+	applicationTypeTitles    = titleTable{name: `application_type_title`, column: `type_id`}
+	applicationSubTypeTitles = titleTable{name: `application_subtype_title`, column: `subtype_id`}
+)
+
+// listTitles returns the translated titles of the items by the item id and the locale.
+func (r *Repo) listTitles(ctx context.Context, table titleTable, ids []uuid.UUID) (map[uuid.UUID]map[string]string, error) {
+	query := `SELECT ` + table.column + `, locale, title
+	FROM ` + table.name + `
+	WHERE ` + table.column + ` = ANY($1::uuid[])`
+
+	rows, err := r.tx.QueryContext(ctx, query, uuidsToStrings(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	titles := map[uuid.UUID]map[string]string{}
+
+	for rows.Next() {
+		var (
+			id            uuid.UUID
+			locale, title string
+		)
+
+		err = rows.Scan(&id, &locale, &title)
+		if err != nil {
+			return nil, err
+		}
+
+		if titles[id] == nil {
+			titles[id] = map[string]string{}
+		}
+		titles[id][locale] = title
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return titles, nil
+}
+
+// replaceTitles replaces all translated titles of the item.
+func (r *Repo) replaceTitles(ctx context.Context, table titleTable, id uuid.UUID, titles map[string]string) error {
+	_, err := r.tx.ExecContext(ctx, `DELETE FROM `+table.name+` WHERE `+table.column+` = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO ` + table.name + ` (` + table.column + `, locale, title) VALUES ($1, $2, $3)`
+
+	for locale, title := range titles {
+		_, err = r.tx.ExecContext(ctx, query, id, locale, title)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
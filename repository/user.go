@@ -3,7 +3,6 @@ package repository
 import (
 	"bio/service"
 	"context"
-	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
@@ -34,7 +33,7 @@ func (r *Repo) GetUser(ctx context.Context, id uuid.UUID) (*service.User, error)
 	user := &service.User{}
 
 	if !rows.Next() {
-		return nil, sql.ErrNoRows
+		return nil, service.ErrNotFound
 	}
 	err = rows.Scan(&user.ID, &user.CreatedAt, &user.FirstName, &user.LastName, &user.Role, &user.Phone)
 	if err != nil {
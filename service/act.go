@@ -0,0 +1,256 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const actContentType = "application/pdf"
+
+// ActFile is the completion act of an application ready to be sent to a client.
+type ActFile struct {
+	Application *Application
+	ContentType string
+	Body        io.ReadCloser
+}
+
+// actKey names the act of the completion at the time, so the act of a reopened application
+// done again is rendered anew.
+func actKey(applicationID uuid.UUID, doneAt time.Time) string {
+	return "acts/" + applicationID.String() + "/" + strconv.FormatInt(doneAt.UnixNano(), 10) + ".pdf"
+}
+
+// formatKopecks formats the amount in kopecks as rubles.
+func formatKopecks(amount int64) string {
+	return fmt.Sprintf("%d.%02d", amount/100, amount%100)
+}
+
+// ActSnapshot is the content of the completion act taken when the application is done,
+// so later changes of titles, addresses and users do not alter the act.
+type ActSnapshot struct {
+	ApplicationID uuid.UUID
+	CreatedAt     time.Time
+	DoneAt        time.Time
+
+	Premises  string
+	SubType   string
+	Creator   string
+	Performer string
+	Text      string
+
+	Items []ApplicationLineItem
+	Costs ApplicationCosts
+}
+
+// saveActSnapshot records the content of the act of the application just done. The act itself
+// is rendered on the first download, so completing the application does not depend on the blob store.
+func (s *Service) saveActSnapshot(ctx context.Context, applicationID uuid.UUID) error {
+	appl, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := s.takeActSnapshot(ctx, appl)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.SaveActSnapshot(ctx, *snapshot)
+}
+
+// takeActSnapshot collects the content of the act of the done application.
+func (s *Service) takeActSnapshot(ctx context.Context, appl *Application) (*ActSnapshot, error) {
+	if appl.DoneAt == nil {
+		return nil, ErrNotFound
+	}
+
+	snapshot := &ActSnapshot{
+		ApplicationID: appl.ID,
+		CreatedAt:     appl.CreatedAt,
+		DoneAt:        *appl.DoneAt,
+		Premises:      "-",
+		Performer:     "-",
+		Text:          appl.Text,
+		Costs:         appl.Costs,
+	}
+
+	if appl.BuildingID != nil {
+		building, err := s.repo.GetBuilding(ctx, *appl.BuildingID)
+		switch {
+		case err == nil:
+			snapshot.Premises = building.Address
+		case !errors.Is(err, ErrNotFound):
+			return nil, err
+		}
+	}
+
+	if appl.ApartmentID != nil {
+		apartment, err := s.repo.GetApartment(ctx, *appl.ApartmentID)
+		switch {
+		case err == nil:
+			snapshot.Premises += ", кв. " + apartment.Number
+		case !errors.Is(err, ErrNotFound):
+			return nil, err
+		}
+	}
+
+	subType, err := s.repo.GetApplicationSubType(ctx, appl.SubType)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.SubType = subType.Title
+
+	creator, err := s.repo.GetUser(ctx, appl.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Creator = creator.LastName + " " + creator.FirstName
+
+	if appl.PerformerID != nil {
+		user, err := s.repo.GetUser(ctx, *appl.PerformerID)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Performer = user.LastName + " " + user.FirstName + ", " + user.Phone
+	}
+
+	snapshot.Items, _, err = s.repo.ListApplicationLineItems(ctx, ApplicationLineItemFilter{ApplicationID: appl.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// renderCompletionAct renders the work completion act from its snapshot.
+func (s *Service) renderCompletionAct(snapshot ActSnapshot) []byte {
+	loc := s.getScheduleLocation()
+	timeFormat := "02.01.2006 15:04"
+
+	doc := newPDFDocument()
+
+	doc.Text("АКТ ВЫПОЛНЕННЫХ РАБОТ", 14, true)
+	doc.Text("по заявке № "+snapshot.ApplicationID.String(), 10, false)
+	doc.Gap(10)
+
+	doc.Text("Адрес: "+snapshot.Premises, 10, false)
+	doc.Text("Вид работ: "+snapshot.SubType, 10, false)
+	doc.Text("Заявитель: "+snapshot.Creator, 10, false)
+	doc.Text("Исполнитель: "+snapshot.Performer, 10, false)
+	doc.Text("Заявка создана: "+snapshot.CreatedAt.In(loc).Format(timeFormat), 10, false)
+	doc.Text("Работы выполнены: "+snapshot.DoneAt.In(loc).Format(timeFormat), 10, false)
+
+	doc.Gap(10)
+	doc.Text("Описание заявки:", 10, true)
+	doc.Text(snapshot.Text, 10, false)
+	doc.Gap(10)
+
+	if len(snapshot.Items) > 0 {
+		doc.Text("Материалы и трудозатраты:", 10, true)
+		doc.Text(fmt.Sprintf("%-3s %-36s %9s %12s %12s %7s", "№", "Наименование", "Кол-во", "Цена, руб.", "Сумма, руб.", "Часы"), 9, false)
+
+		for i, item := range snapshot.Items {
+			material := item.Material
+			if material == "" {
+				material = "Работы"
+			}
+
+			doc.Text(fmt.Sprintf("%-3d %-36s %9s %12s %12s %7.2f", i+1, truncateRunes(material, 36),
+				strconv.FormatFloat(item.Quantity, 'f', -1, 64),
+				formatKopecks(item.UnitPrice), formatKopecks(item.Cost()), item.LabourHours), 9, false)
+		}
+
+		doc.Gap(6)
+		doc.Text("Итого материалы: "+formatKopecks(snapshot.Costs.MaterialsCost)+" руб.", 10, true)
+		doc.Text(fmt.Sprintf("Итого трудозатраты: %.2f ч.", snapshot.Costs.LabourHours), 10, true)
+		doc.Gap(10)
+	}
+
+	doc.Text("Работы выполнены в полном объеме. Заявитель претензий по объему, качеству и срокам не имеет.", 10, false)
+	doc.Gap(30)
+	doc.Text("Исполнитель ____________________        Заявитель ____________________", 10, false)
+
+	return doc.Bytes()
+}
+
+// truncateRunes cuts the text to the number of characters.
+func truncateRunes(text string, n int) string {
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+
+	return string(runes[:n])
+}
+
+// GetCompletionAct returns the act of the done application to its creator, its performer or a moderator.
+// The act is rendered from its snapshot on the first download and kept in the blob store.
+// The caller must close ActFile.Body.
+func (s *Service) GetCompletionAct(ctx context.Context, userID, applicationID uuid.UUID) (*ActFile, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	appl, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isParticipant(appl, user) {
+		return nil, ErrForbidden
+	}
+
+	// the act of a reopened application is outdated
+	if appl.Status != ApplStatusDone || appl.DoneAt == nil {
+		return nil, ErrNotFound
+	}
+
+	key := actKey(appl.ID, *appl.DoneAt)
+
+	body, err := s.blobs.Get(ctx, key)
+	if errors.Is(err, ErrNotFound) {
+		snapshot, err := s.getActSnapshot(ctx, appl)
+		if err != nil {
+			return nil, err
+		}
+
+		data := s.renderCompletionAct(*snapshot)
+
+		err = s.blobs.Put(ctx, key, actContentType, bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+
+		body = io.NopCloser(bytes.NewReader(data))
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &ActFile{
+		Application: appl,
+		ContentType: actContentType,
+		Body:        body,
+	}, nil
+}
+
+// getActSnapshot returns the snapshot taken when the application was done. Applications done
+// before snapshots were recorded get one from the current data.
+func (s *Service) getActSnapshot(ctx context.Context, appl *Application) (*ActSnapshot, error) {
+	snapshot, err := s.repo.GetActSnapshot(ctx, appl.ID)
+	switch {
+	case err == nil && snapshot.DoneAt.Equal(*appl.DoneAt):
+		return snapshot, nil
+	case err != nil && !errors.Is(err, ErrNotFound):
+		return nil, err
+	}
+
+	return s.takeActSnapshot(ctx, appl)
+}
@@ -14,62 +14,277 @@ const (
 	ApplStatusCreated    ApplicationStatus = "created"
 	ApplStatusInProgress ApplicationStatus = "inprogress"
 	ApplStatusDone       ApplicationStatus = "done"
+	ApplStatusCancelled  ApplicationStatus = "cancelled"
+	ApplStatusRejected   ApplicationStatus = "rejected"
+	ApplStatusReopened   ApplicationStatus = "reopened"
 )
 
+type ApplicationPriority string
+
+const (
+	ApplPriorityLow       ApplicationPriority = "low"
+	ApplPriorityNormal    ApplicationPriority = "normal"
+	ApplPriorityHigh      ApplicationPriority = "high"
+	ApplPriorityEmergency ApplicationPriority = "emergency"
+)
+
+var applicationPriorityRanks = map[ApplicationPriority]int{
+	ApplPriorityLow:       0,
+	ApplPriorityNormal:    1,
+	ApplPriorityHigh:      2,
+	ApplPriorityEmergency: 3,
+}
+
+// Rank orders priorities from low to emergency.
+func (p ApplicationPriority) Rank() int {
+	return applicationPriorityRanks[p]
+}
+
 type Application struct {
 	ID        uuid.UUID
 	CreatedAt time.Time
 	CreatorID uuid.UUID
 	UpdatedAt time.Time
 
-	Status  ApplicationStatus
-	Type    uuid.UUID
-	SubType uuid.UUID
+	Status   ApplicationStatus
+	Priority ApplicationPriority
+	Type     uuid.UUID
+	SubType  uuid.UUID
+
+	// BuildingID and ApartmentID are the premises of the application. The building is required
+	// for new applications, the apartment is not set for common areas like an elevator or a roof.
+	BuildingID  *uuid.UUID
+	ApartmentID *uuid.UUID
+	// Location is the location of the building, it is read only.
+	Location *GeoPoint
 
 	Text string
 
-	// TODO: в отдельную таблицу вставлять фотографии
 	PhotoIDs []uuid.UUID
+	// AddPhotoIDs and RemovePhotoIDs are used only on update.
+	AddPhotoIDs    []uuid.UUID
+	RemovePhotoIDs []uuid.UUID
 
 	PerformerID   *uuid.UUID
 	PerformerTime *time.Time
+	// VisitSlot is the booked visit of the performer, it is read only.
+	VisitSlot *VisitSlot
+
+	// ResponseDueAt and DueAt are the deadlines to take the application into work and to complete it.
+	ResponseDueAt *time.Time
+	DueAt         *time.Time
+
+	// Rating and Review are the feedback of the creator after the application is done.
+	Rating  *int
+	Review  *string
+	RatedAt *time.Time
+
+	// DoneAt is the time of the last completion, ReopenCount is how many times the application was reopened.
+	DoneAt      *time.Time
+	ReopenCount int
+
+	// DuplicateOfID links the application closed as a duplicate to the original one.
+	DuplicateOfID *uuid.UUID
+
+	// RecurrenceID is the maintenance template the application was created from.
+	RecurrenceID *uuid.UUID
+
+	// Costs are the totals of the line items, they are read only.
+	Costs ApplicationCosts
+
+	// Fields are the custom fields described by the schema of the application type.
+	// On update nil fields are left as is, otherwise they are replaced.
+	Fields map[string]interface{}
 }
 
 type ApplicationFilter struct {
 	PerformerID *uuid.UUID
 	CreatorID   *uuid.UUID
 	Status      ApplicationStatus
+	Priority    ApplicationPriority
 	Type        *uuid.UUID
+	BuildingID  *uuid.UUID
+	ApartmentID *uuid.UUID
+	Overdue     *bool
+	// RecurrenceID selects applications created from the maintenance template.
+	RecurrenceID *uuid.UUID
+	// Query is the full-text search over the application text.
+	Query string
+	// Fields selects applications whose custom fields have all the given values.
+	Fields map[string]interface{}
+
+	// BoundingBox and Radius limit applications by the location of their buildings,
+	// Point is the center of the radius and the origin of the distance ordering.
+	BoundingBox *GeoBox
+	Point       *GeoPoint
+	Radius      *float64
 
 	Pagination pagination.Pagination
 }
 
 type ApplicationType struct {
-	ID    uuid.UUID
-	Title string
+	ID uuid.UUID
+	// Title is the default title, Titles are its translations by the lowercase locale like "en" or "en-gb".
+	Title  string
+	Titles map[string]string
+
+	// FieldsSchema is the JSON Schema of the custom fields of applications, nil if the type has none.
+	FieldsSchema map[string]interface{}
+
+	// Position orders types in lists. Archived types are not offered for new applications.
+	Position   int
+	ArchivedAt *time.Time
 }
 
 type ApplicationSubType struct {
-	ID    uuid.UUID
-	Title string
-	Type  uuid.UUID
+	ID uuid.UUID
+	// Title is the default title, Titles are its translations by the lowercase locale like "en" or "en-gb".
+	Title  string
+	Titles map[string]string
+	Type   uuid.UUID
+
+	// ResponseMinutes and ResolutionMinutes are the SLA of the subtype, nil means no deadline.
+	ResponseMinutes   *int
+	ResolutionMinutes *int
+
+	// Position orders subtypes of a type in lists. Archived subtypes are not offered for new applications.
+	Position   int
+	ArchivedAt *time.Time
 }
 
-func (s *Service) CreateApplication(ctx context.Context, appl Application) (*Application, error) {
-	err := s.repo.CreateApplication(ctx, appl)
+// CreateApplication saves the application and returns it with open applications that may be its duplicates.
+func (s *Service) CreateApplication(ctx context.Context, appl Application) (*Application, []*Application, error) {
+	err := s.checkPremises(ctx, appl)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return s.repo.GetApplication(ctx, appl.ID)
+	err = s.checkPhotos(ctx, appl.PhotoIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subType, err := s.repo.GetApplicationSubType(ctx, appl.SubType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	applType, err := s.repo.GetApplicationType(ctx, appl.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// archived types and subtypes are kept only for the applications created before
+	if applType.ArchivedAt != nil || subType.ArchivedAt != nil {
+		return nil, nil, ErrArchived
+	}
+
+	appl.ResponseDueAt, appl.DueAt = subType.Deadlines(appl.CreatedAt)
+
+	err = checkApplicationFields(applType, appl.Fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if appl.Priority == "" {
+		appl.Priority = ApplPriorityNormal
+	}
+
+	duplicates, err := s.findDuplicates(ctx, appl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.repo.CreateApplication(ctx, appl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.copyChecklist(ctx, appl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.dispatchApplication(ctx, appl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created, err := s.repo.GetApplication(ctx, appl.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return created, duplicates, nil
 }
 
 func (s *Service) GetApplication(ctx context.Context, id uuid.UUID) (*Application, error) {
 	return s.repo.GetApplication(ctx, id)
 }
 
-func (s *Service) UpdateApplication(ctx context.Context, appl Application) (*Application, error) {
-	err := s.repo.UpdateApplication(ctx, appl)
+func (s *Service) UpdateApplication(ctx context.Context, userID uuid.UUID, appl Application) (*Application, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.GetApplication(ctx, appl.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkApplicationTransition(current, appl, user)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkPriorityChange(current, appl, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// residents and workers agree on the visit time by booking a slot
+	if appl.PerformerTime != nil && user.Role != UserRoleModerator {
+		return nil, ErrForbidden
+	}
+
+	if appl.PerformerID != nil && (current.PerformerID == nil || *current.PerformerID != *appl.PerformerID) {
+		if user.Role != UserRoleModerator {
+			return nil, ErrForbidden
+		}
+
+		if !isAssignable(current.Status) {
+			return nil, &AssignmentError{Status: current.Status, Reason: "application can not be assigned"}
+		}
+
+		err = s.checkPerformer(ctx, *appl.PerformerID, current.Status)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.checkPhotos(ctx, appl.AddPhotoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if appl.Fields != nil {
+		if user.Role != UserRoleModerator && current.CreatorID != user.ID {
+			return nil, ErrForbidden
+		}
+
+		applType, err := s.repo.GetApplicationType(ctx, current.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkApplicationFields(applType, appl.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.updateApplication(ctx, user.ID, current, appl, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -77,14 +292,106 @@ func (s *Service) UpdateApplication(ctx context.Context, appl Application) (*App
 	return s.repo.GetApplication(ctx, appl.ID)
 }
 
-func (s *Service) ListApplication(ctx context.Context, filter ApplicationFilter) ([]*Application, int, error) {
-	return s.repo.ListApplication(ctx, filter)
+// isParticipant reports whether the user is the creator or the performer of the application or a moderator.
+func isParticipant(appl *Application, user *User) bool {
+	isPerformer := appl.PerformerID != nil && *appl.PerformerID == user.ID
+
+	return appl.CreatorID == user.ID || isPerformer || user.Role == UserRoleModerator
+}
+
+// checkPriorityChange allows moderators to set any priority,
+// while the creator of the application may only raise it.
+func checkPriorityChange(current *Application, update Application, user *User) error {
+	if update.Priority == "" || update.Priority == current.Priority {
+		return nil
+	}
+
+	switch user.Role {
+	case UserRoleModerator:
+		return nil
+	case UserRoleUser:
+		if current.CreatorID == user.ID && update.Priority.Rank() > current.Priority.Rank() {
+			return nil
+		}
+	}
+
+	return ErrForbidden
+}
+
+// updateApplication saves the update and records the changed fields to the application history.
+// The comment, if any, explains the status change.
+func (s *Service) updateApplication(ctx context.Context, authorID uuid.UUID, current *Application, update Application, comment *string) error {
+	if update.Status != "" && update.Status != current.Status {
+		switch update.Status {
+		case ApplStatusDone:
+			err := s.checkChecklistDone(ctx, current.ID)
+			if err != nil {
+				return err
+			}
+			update.DoneAt = toPoint(time.Now().UTC())
+		case ApplStatusReopened:
+			update.ReopenCount = current.ReopenCount + 1
+
+			// the SLA of the reopened application starts over
+			subType, err := s.repo.GetApplicationSubType(ctx, current.SubType)
+			if err != nil {
+				return err
+			}
+			update.ResponseDueAt, update.DueAt = subType.Deadlines(time.Now().UTC())
+		}
+	}
+
+	err := s.releaseVisitSlots(ctx, current, update)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.UpdateApplication(ctx, update)
+	if err != nil {
+		return err
+	}
+
+	events := applicationEvents(authorID, current, update)
+	for i := range events {
+		if events[i].Field == ApplEventFieldStatus {
+			events[i].Comment = comment
+		}
+	}
+
+	err = s.repo.CreateApplicationEvents(ctx, events)
+	if err != nil {
+		return err
+	}
+
+	if update.Status == ApplStatusDone && current.Status != ApplStatusDone {
+		return s.saveActSnapshot(ctx, current.ID)
+	}
+
+	return nil
 }
 
-func (s *Service) ListApplicationTypes(ctx context.Context, filter ApplicationFilter) ([]ApplicationType, int, error) {
-	return s.repo.ListApplicationTypes(ctx, filter)
+// releaseVisitSlots frees the slots booked for the application when nobody is going to visit:
+// the performer changes, the application is cancelled, rejected or marked as a duplicate.
+// A done application keeps the past visit and frees only the slots ahead.
+func (s *Service) releaseVisitSlots(ctx context.Context, current *Application, update Application) error {
+	if update.PerformerID != nil && (current.PerformerID == nil || *current.PerformerID != *update.PerformerID) {
+		return s.repo.ReleaseVisitSlots(ctx, current.ID)
+	}
+
+	if update.Status == "" || update.Status == current.Status {
+		return nil
+	}
+
+	switch update.Status {
+	case ApplStatusCancelled, ApplStatusRejected:
+		return s.repo.ReleaseVisitSlots(ctx, current.ID)
+	case ApplStatusDone:
+		return s.repo.ReleaseVisitSlotsFrom(ctx, current.ID, time.Now().UTC())
+	}
+
+	return nil
 }
 
-func (s *Service) ListApplicationSubtypes(ctx context.Context, filter ApplicationFilter) ([]ApplicationSubType, int, error) {
-	return s.repo.ListApplicationSubTypes(ctx, filter)
+func (s *Service) ListApplication(ctx context.Context, filter ApplicationFilter) ([]*Application, int, error) {
+	return s.repo.ListApplication(ctx, filter)
 }
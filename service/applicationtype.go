@@ -0,0 +1,403 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"bio/pagination"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidCategory is returned for an application type or subtype without a title, with a malformed
+	// translation, fields schema or a negative SLA, or for a reorder list that does not match the types
+	// or the subtypes of the type.
+	ErrInvalidCategory = errors.New("InvalidCategory")
+	// ErrArchived is returned when an application is created with an archived type or subtype.
+	ErrArchived = errors.New("application type or subtype is archived")
+)
+
+// ApplicationTypeUpdate holds the type fields to change, nil fields are left as is.
+type ApplicationTypeUpdate struct {
+	ID    uuid.UUID
+	Title *string
+	// Titles replace all translations of the title.
+	Titles       map[string]string
+	FieldsSchema map[string]interface{}
+	// ClearFieldsSchema removes the custom fields of the type, FieldsSchema must not be set then.
+	ClearFieldsSchema bool
+}
+
+// ApplicationSubTypeUpdate holds the subtype fields to change, nil fields are left as is.
+type ApplicationSubTypeUpdate struct {
+	ID    uuid.UUID
+	Title *string
+	// Titles replace all translations of the title.
+	Titles            map[string]string
+	ResponseMinutes   *int
+	ResolutionMinutes *int
+	// ClearResponseMinutes and ClearResolutionMinutes remove the deadline, the minutes must not be set then.
+	ClearResponseMinutes   bool
+	ClearResolutionMinutes bool
+}
+
+type ApplicationTypeFilter struct {
+	// Search matches the title.
+	Search string
+	// IncludeArchived lists archived types too.
+	IncludeArchived bool
+	// Locales are the preferred locales of the titles, the most preferred first.
+	Locales []string
+
+	Pagination pagination.Pagination
+}
+
+type ApplicationSubTypeFilter struct {
+	Type *uuid.UUID
+	// Search matches the title.
+	Search string
+	// IncludeArchived lists archived subtypes and subtypes of archived types too.
+	IncludeArchived bool
+	// Locales are the preferred locales of the titles, the most preferred first.
+	Locales []string
+
+	Pagination pagination.Pagination
+}
+
+// normalize checks the title, the translations and the fields schema and lowercases the locales.
+func (t *ApplicationType) normalize() bool {
+	var ok bool
+
+	t.Titles, ok = normalizeTitles(t.Titles)
+
+	if !ok || strings.TrimSpace(t.Title) == "" {
+		return false
+	}
+
+	return t.FieldsSchema == nil || checkJSONSchema(t.FieldsSchema)
+}
+
+// normalize checks the title, the translations and the SLA and lowercases the locales.
+func (t *ApplicationSubType) normalize() bool {
+	var ok bool
+
+	t.Titles, ok = normalizeTitles(t.Titles)
+
+	if !ok || strings.TrimSpace(t.Title) == "" {
+		return false
+	}
+
+	if t.ResponseMinutes != nil && *t.ResponseMinutes < 0 {
+		return false
+	}
+
+	return t.ResolutionMinutes == nil || *t.ResolutionMinutes >= 0
+}
+
+// ListApplicationTypes returns the types with the titles in the preferred locale of the filter.
+func (s *Service) ListApplicationTypes(ctx context.Context, filter ApplicationTypeFilter) ([]ApplicationType, int, error) {
+	types, total, err := s.repo.ListApplicationTypes(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range types {
+		types[i].Title = localizedTitle(types[i].Title, types[i].Titles, filter.Locales)
+	}
+
+	return types, total, nil
+}
+
+// ListApplicationSubtypes returns the subtypes with the titles in the preferred locale of the filter.
+func (s *Service) ListApplicationSubtypes(ctx context.Context, filter ApplicationSubTypeFilter) ([]ApplicationSubType, int, error) {
+	subTypes, total, err := s.repo.ListApplicationSubTypes(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range subTypes {
+		subTypes[i].Title = localizedTitle(subTypes[i].Title, subTypes[i].Titles, filter.Locales)
+	}
+
+	return subTypes, total, nil
+}
+
+// CreateApplicationType adds the type after the existing ones. Only for moderators.
+func (s *Service) CreateApplicationType(ctx context.Context, userID uuid.UUID, applType ApplicationType) (*ApplicationType, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !applType.normalize() {
+		return nil, ErrInvalidCategory
+	}
+
+	err = s.repo.CreateApplicationType(ctx, applType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationType(ctx, applType.ID)
+}
+
+// UpdateApplicationType changes the type. Only for moderators.
+// The new schema of the custom fields applies to new applications and to changes of the fields.
+func (s *Service) UpdateApplicationType(ctx context.Context, userID uuid.UUID, update ApplicationTypeUpdate) (*ApplicationType, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	applType, err := s.repo.GetApplicationType(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Title != nil {
+		applType.Title = *update.Title
+	}
+
+	if update.Titles != nil {
+		applType.Titles = update.Titles
+	}
+
+	if update.ClearFieldsSchema {
+		if update.FieldsSchema != nil {
+			return nil, ErrInvalidCategory
+		}
+		applType.FieldsSchema = nil
+	}
+
+	if update.FieldsSchema != nil {
+		applType.FieldsSchema = update.FieldsSchema
+	}
+
+	if !applType.normalize() {
+		return nil, ErrInvalidCategory
+	}
+
+	err = s.repo.UpdateApplicationType(ctx, *applType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationType(ctx, applType.ID)
+}
+
+// SetApplicationTypeArchived archives the type or returns it back. Only for moderators.
+func (s *Service) SetApplicationTypeArchived(ctx context.Context, userID, id uuid.UUID, archived bool) (*ApplicationType, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	applType, err := s.repo.GetApplicationType(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	applType.ArchivedAt = archivedAt(applType.ArchivedAt, archived)
+
+	err = s.repo.UpdateApplicationType(ctx, *applType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationType(ctx, applType.ID)
+}
+
+// ReorderApplicationTypes sets the order of the types, the list has to contain every type exactly once.
+// Only for moderators.
+func (s *Service) ReorderApplicationTypes(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	types, _, err := s.repo.ListApplicationTypes(ctx, ApplicationTypeFilter{IncludeArchived: true})
+	if err != nil {
+		return err
+	}
+
+	current := make([]uuid.UUID, 0, len(types))
+	for _, t := range types {
+		current = append(current, t.ID)
+	}
+
+	if !isPermutation(current, ids) {
+		return ErrInvalidCategory
+	}
+
+	return s.repo.SetApplicationTypePositions(ctx, ids)
+}
+
+// CreateApplicationSubType adds the subtype after the existing subtypes of its type. Only for moderators.
+func (s *Service) CreateApplicationSubType(ctx context.Context, userID uuid.UUID, subType ApplicationSubType) (*ApplicationSubType, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.repo.GetApplicationType(ctx, subType.Type)
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrInvalidCategory
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !subType.normalize() {
+		return nil, ErrInvalidCategory
+	}
+
+	err = s.repo.CreateApplicationSubType(ctx, subType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationSubType(ctx, subType.ID)
+}
+
+// UpdateApplicationSubType changes the subtype. Only for moderators.
+// The new SLA applies to applications created after the change.
+func (s *Service) UpdateApplicationSubType(ctx context.Context, userID uuid.UUID, update ApplicationSubTypeUpdate) (*ApplicationSubType, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	subType, err := s.repo.GetApplicationSubType(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Title != nil {
+		subType.Title = *update.Title
+	}
+
+	if update.Titles != nil {
+		subType.Titles = update.Titles
+	}
+
+	if (update.ClearResponseMinutes && update.ResponseMinutes != nil) ||
+		(update.ClearResolutionMinutes && update.ResolutionMinutes != nil) {
+		return nil, ErrInvalidCategory
+	}
+
+	if update.ClearResponseMinutes {
+		subType.ResponseMinutes = nil
+	}
+
+	if update.ClearResolutionMinutes {
+		subType.ResolutionMinutes = nil
+	}
+
+	if update.ResponseMinutes != nil {
+		subType.ResponseMinutes = update.ResponseMinutes
+	}
+
+	if update.ResolutionMinutes != nil {
+		subType.ResolutionMinutes = update.ResolutionMinutes
+	}
+
+	if !subType.normalize() {
+		return nil, ErrInvalidCategory
+	}
+
+	err = s.repo.UpdateApplicationSubType(ctx, *subType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationSubType(ctx, subType.ID)
+}
+
+// SetApplicationSubTypeArchived archives the subtype or returns it back. Only for moderators.
+func (s *Service) SetApplicationSubTypeArchived(ctx context.Context, userID, id uuid.UUID, archived bool) (*ApplicationSubType, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	subType, err := s.repo.GetApplicationSubType(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subType.ArchivedAt = archivedAt(subType.ArchivedAt, archived)
+
+	err = s.repo.UpdateApplicationSubType(ctx, *subType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationSubType(ctx, subType.ID)
+}
+
+// ReorderApplicationSubTypes sets the order of the subtypes of the type,
+// the list has to contain every subtype of the type exactly once. Only for moderators.
+func (s *Service) ReorderApplicationSubTypes(ctx context.Context, userID, typeID uuid.UUID, ids []uuid.UUID) error {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetApplicationType(ctx, typeID)
+	if err != nil {
+		return err
+	}
+
+	subTypes, _, err := s.repo.ListApplicationSubTypes(ctx, ApplicationSubTypeFilter{Type: &typeID, IncludeArchived: true})
+	if err != nil {
+		return err
+	}
+
+	current := make([]uuid.UUID, 0, len(subTypes))
+	for _, t := range subTypes {
+		current = append(current, t.ID)
+	}
+
+	if !isPermutation(current, ids) {
+		return ErrInvalidCategory
+	}
+
+	return s.repo.SetApplicationSubTypePositions(ctx, ids)
+}
+
+// archivedAt keeps the time of the archiving when the archived flag is not changed.
+func archivedAt(current *time.Time, archived bool) *time.Time {
+	switch {
+	case !archived:
+		return nil
+	case current != nil:
+		return current
+	default:
+		return toPoint(time.Now().UTC())
+	}
+}
+
+// isPermutation checks that ids contain every one of the current ids exactly once.
+func isPermutation(current, ids []uuid.UUID) bool {
+	if len(current) != len(ids) {
+		return false
+	}
+
+	seen := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		seen[id] = false
+	}
+
+	for _, id := range ids {
+		done, ok := seen[id]
+		if !ok || done {
+			return false
+		}
+		seen[id] = true
+	}
+
+	return true
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// AssignmentError is returned when the performer of an application can not be changed.
+type AssignmentError struct {
+	Status ApplicationStatus
+	Reason string
+}
+
+func (e *AssignmentError) Error() string {
+	return fmt.Sprintf("assignment of application in status %q: %s", e.Status, e.Reason)
+}
+
+// isAssignable reports whether the performer of the application may be changed.
+func isAssignable(status ApplicationStatus) bool {
+	return status == ApplStatusCreated || status == ApplStatusReopened
+}
+
+// checkPerformer makes sure that the performer exists, is a worker and is at work now.
+func (s *Service) checkPerformer(ctx context.Context, performerID uuid.UUID, status ApplicationStatus) error {
+	performer, err := s.repo.GetUser(ctx, performerID)
+	if err != nil {
+		return err
+	}
+
+	if performer.Role != UserRoleWorker {
+		return &AssignmentError{Status: status, Reason: "performer is not a worker"}
+	}
+
+	return s.checkWorkerAvailability(ctx, performer.ID, status)
+}
+
+// AssignPerformer lets a moderator assign a worker to the application.
+// The worker has to accept the assignment to start the work.
+func (s *Service) AssignPerformer(ctx context.Context, userID, applicationID, performerID uuid.UUID) (*Application, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role != UserRoleModerator {
+		return nil, ErrForbidden
+	}
+
+	current, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAssignable(current.Status) {
+		return nil, &AssignmentError{Status: current.Status, Reason: "application can not be assigned"}
+	}
+
+	err = s.checkPerformer(ctx, performerID, current.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.updateApplication(ctx, user.ID, current, Application{ID: applicationID, PerformerID: &performerID}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplication(ctx, applicationID)
+}
+
+// UnassignPerformer lets a moderator take the application away from its performer.
+func (s *Service) UnassignPerformer(ctx context.Context, userID, applicationID uuid.UUID) (*Application, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role != UserRoleModerator {
+		return nil, ErrForbidden
+	}
+
+	err = s.clearPerformer(ctx, user.ID, applicationID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplication(ctx, applicationID)
+}
+
+// AcceptAssignment lets the assigned worker take the application into work.
+func (s *Service) AcceptAssignment(ctx context.Context, userID, applicationID uuid.UUID) (*Application, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.PerformerID == nil || *current.PerformerID != user.ID {
+		return nil, ErrForbidden
+	}
+
+	update := Application{ID: applicationID, Status: ApplStatusInProgress}
+
+	err = s.checkApplicationTransition(current, update, user)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.updateApplication(ctx, user.ID, current, update, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplication(ctx, applicationID)
+}
+
+// DeclineAssignment lets the assigned worker refuse the application.
+// The application goes back to moderators without a performer.
+func (s *Service) DeclineAssignment(ctx context.Context, userID, applicationID uuid.UUID, reason string) (*Application, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.PerformerID == nil || *current.PerformerID != user.ID {
+		return nil, ErrForbidden
+	}
+
+	err = s.clearPerformer(ctx, user.ID, applicationID, &reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplication(ctx, applicationID)
+}
+
+func (s *Service) clearPerformer(ctx context.Context, authorID, applicationID uuid.UUID, reason *string) error {
+	current, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+
+	if current.PerformerID == nil {
+		return &AssignmentError{Status: current.Status, Reason: "performer is not set"}
+	}
+
+	if !isAssignable(current.Status) {
+		return &AssignmentError{Status: current.Status, Reason: "performer can not be removed"}
+	}
+
+	err = s.repo.ReleaseVisitSlots(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.ClearApplicationPerformer(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+
+	event := newApplicationEvent(applicationID, &authorID, ApplEventFieldPerformerID, uuidToString(current.PerformerID), nil)
+	event.Comment = reason
+
+	return s.repo.CreateApplicationEvents(ctx, []ApplicationEvent{event})
+}
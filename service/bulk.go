@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"bio/pagination"
+
+	"github.com/google/uuid"
+)
+
+// MaxBulkApplications limits the applications changed by one bulk operation.
+const MaxBulkApplications = 500
+
+// ErrInvalidBulk is returned for a bulk operation without a change, with both or neither ids and a filter,
+// with a filter without criteria or with more than MaxBulkApplications applications.
+var ErrInvalidBulk = errors.New("InvalidBulk")
+
+// BulkChange is the change applied to every application of a bulk operation, empty fields are left as is.
+type BulkChange struct {
+	Status      ApplicationStatus
+	PerformerID *uuid.UUID
+	Priority    ApplicationPriority
+}
+
+// BulkUpdate selects the applications of a bulk operation by the ids or by the filter.
+type BulkUpdate struct {
+	IDs    []uuid.UUID
+	Filter *ApplicationFilter
+	Change BulkChange
+}
+
+// hasCriteria reports whether the filter narrows the applications down by the fields a bulk operation sets.
+func (f ApplicationFilter) hasCriteria() bool {
+	return f.PerformerID != nil || f.CreatorID != nil || f.Status != "" || f.Priority != "" ||
+		f.Type != nil || f.BuildingID != nil || f.ApartmentID != nil || f.Overdue != nil ||
+		f.RecurrenceID != nil || f.Query != "" || len(f.Fields) != 0
+}
+
+// PlanBulkUpdate returns the ids of the applications to change: the listed ids without repeats
+// or every application matching the filter, emergency ones first. Only for moderators.
+func (s *Service) PlanBulkUpdate(ctx context.Context, userID uuid.UUID, bulk BulkUpdate) ([]uuid.UUID, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	change := bulk.Change
+	if change.Status == "" && change.PerformerID == nil && change.Priority == "" {
+		return nil, ErrInvalidBulk
+	}
+
+	if (len(bulk.IDs) == 0) == (bulk.Filter == nil) {
+		return nil, ErrInvalidBulk
+	}
+
+	if bulk.Filter == nil {
+		ids := make([]uuid.UUID, 0, len(bulk.IDs))
+		seen := make(map[uuid.UUID]bool, len(bulk.IDs))
+
+		for _, id := range bulk.IDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+
+		if len(ids) > MaxBulkApplications {
+			return nil, ErrInvalidBulk
+		}
+
+		return ids, nil
+	}
+
+	// an empty filter would select every application
+	if !bulk.Filter.hasCriteria() {
+		return nil, ErrInvalidBulk
+	}
+
+	filter := *bulk.Filter
+	filter.Pagination = pagination.Pagination{Limit: MaxBulkApplications}
+
+	applications, total, err := s.repo.ListApplication(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if total > MaxBulkApplications {
+		return nil, ErrInvalidBulk
+	}
+
+	ids := make([]uuid.UUID, 0, len(applications))
+	for _, appl := range applications {
+		ids = append(ids, appl.ID)
+	}
+
+	return ids, nil
+}
+
+// ApplyBulkChange changes one application of a bulk operation with the same checks as UpdateApplication.
+// Only for moderators.
+func (s *Service) ApplyBulkChange(ctx context.Context, userID, applicationID uuid.UUID, change BulkChange) error {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.UpdateApplication(ctx, userID, Application{
+		ID:          applicationID,
+		Status:      change.Status,
+		PerformerID: change.PerformerID,
+		Priority:    change.Priority,
+	})
+
+	return err
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidChecklist is returned for a checklist item without a title.
+	ErrInvalidChecklist = errors.New("InvalidChecklist")
+	// ErrChecklistIncomplete is returned when the application is done while required checklist items are open.
+	ErrChecklistIncomplete = errors.New("required checklist items are not done")
+	// ErrChecklistLocked is returned when checklist items are ticked off out of the work on the application.
+	ErrChecklistLocked = errors.New("checklist items can be ticked off only while the application is in progress")
+)
+
+// ChecklistItem is a step of the work on applications of a subtype.
+type ChecklistItem struct {
+	Title string
+	// Required items have to be done before the application is done.
+	Required bool
+}
+
+// ApplicationChecklistItem is a checklist item copied onto the application when it is created.
+type ApplicationChecklistItem struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	Position      int
+	ChecklistItem
+
+	DoneAt *time.Time
+	DoneBy *uuid.UUID
+}
+
+func (s *Service) GetSubTypeChecklist(ctx context.Context, subTypeID uuid.UUID) ([]ChecklistItem, error) {
+	_, err := s.repo.GetApplicationSubType(ctx, subTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListSubTypeChecklist(ctx, subTypeID)
+}
+
+// SetSubTypeChecklist replaces the checklist of the subtype. Only for moderators.
+// Applications created before keep their checklists.
+func (s *Service) SetSubTypeChecklist(ctx context.Context, userID, subTypeID uuid.UUID, items []ChecklistItem) ([]ChecklistItem, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.repo.GetApplicationSubType(ctx, subTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if strings.TrimSpace(item.Title) == "" {
+			return nil, ErrInvalidChecklist
+		}
+	}
+
+	err = s.repo.ReplaceSubTypeChecklist(ctx, subTypeID, items)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListSubTypeChecklist(ctx, subTypeID)
+}
+
+// copyChecklist copies the checklist of the application subtype onto the application.
+func (s *Service) copyChecklist(ctx context.Context, appl Application) error {
+	items, err := s.repo.ListSubTypeChecklist(ctx, appl.SubType)
+	if err != nil {
+		return err
+	}
+
+	copied := make([]ApplicationChecklistItem, 0, len(items))
+
+	for i, item := range items {
+		copied = append(copied, ApplicationChecklistItem{
+			ID:            uuid.New(),
+			ApplicationID: appl.ID,
+			Position:      i,
+			ChecklistItem: item,
+		})
+	}
+
+	return s.repo.CreateApplicationChecklist(ctx, copied)
+}
+
+func (s *Service) ListApplicationChecklist(ctx context.Context, applicationID uuid.UUID) ([]ApplicationChecklistItem, error) {
+	_, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListApplicationChecklist(ctx, applicationID)
+}
+
+// SetApplicationChecklistItemDone ticks the item off or opens it again. Only the performer does it while at work.
+func (s *Service) SetApplicationChecklistItemDone(ctx context.Context, userID, applicationID, itemID uuid.UUID, done bool) (*ApplicationChecklistItem, error) {
+	err := s.checkPerformerAtWork(ctx, userID, applicationID, ErrChecklistLocked)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.GetApplicationChecklistItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.ApplicationID != applicationID {
+		return nil, ErrNotFound
+	}
+
+	item.DoneAt, item.DoneBy = nil, nil
+
+	if done {
+		item.DoneAt = toPoint(time.Now().UTC())
+		item.DoneBy = &userID
+	}
+
+	err = s.repo.UpdateApplicationChecklistItem(ctx, *item)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationChecklistItem(ctx, item.ID)
+}
+
+// checkChecklistDone makes sure that all required checklist items of the application are done.
+func (s *Service) checkChecklistDone(ctx context.Context, applicationID uuid.UUID) error {
+	items, err := s.repo.ListApplicationChecklist(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if item.Required && item.DoneAt == nil {
+			return ErrChecklistIncomplete
+		}
+	}
+
+	return nil
+}
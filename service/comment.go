@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"bio/pagination"
+
+	"github.com/google/uuid"
+)
+
+type ApplicationComment struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	AuthorID      uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	Text string
+	// Internal comments are visible only to moderators and workers.
+	Internal bool
+}
+
+// ApplicationCommentUpdate holds the comment fields to change, nil fields are left as is.
+type ApplicationCommentUpdate struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+
+	Text     *string
+	Internal *bool
+}
+
+type ApplicationCommentFilter struct {
+	ApplicationID uuid.UUID
+	WithInternal  bool
+
+	Pagination pagination.Pagination
+}
+
+// CreateApplicationComment adds a comment of the creator, the performer or a moderator of the application.
+func (s *Service) CreateApplicationComment(ctx context.Context, userID uuid.UUID, comment ApplicationComment) (*ApplicationComment, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Internal && !user.Role.IsStaff() {
+		return nil, ErrForbidden
+	}
+
+	appl, err := s.repo.GetApplication(ctx, comment.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isParticipant(appl, user) {
+		return nil, ErrForbidden
+	}
+
+	comment.AuthorID = user.ID
+
+	err = s.repo.CreateApplicationComment(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationComment(ctx, comment.ID)
+}
+
+// ListApplicationComments returns the comments to the creator, the performer or a moderator of the application.
+func (s *Service) ListApplicationComments(ctx context.Context, userID uuid.UUID, filter ApplicationCommentFilter) ([]ApplicationComment, int, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	appl, err := s.repo.GetApplication(ctx, filter.ApplicationID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !isParticipant(appl, user) {
+		return nil, 0, ErrForbidden
+	}
+
+	filter.WithInternal = user.Role.IsStaff()
+
+	return s.repo.ListApplicationComments(ctx, filter)
+}
+
+// getVisibleComment returns the comment if the user is allowed to see it.
+func (s *Service) getVisibleComment(ctx context.Context, user *User, applicationID, commentID uuid.UUID) (*ApplicationComment, error) {
+	comment, err := s.repo.GetApplicationComment(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.ApplicationID != applicationID || (comment.Internal && !user.Role.IsStaff()) {
+		return nil, ErrNotFound
+	}
+
+	return comment, nil
+}
+
+// UpdateApplicationComment changes the text or the visibility of a comment. Only the author may edit it.
+func (s *Service) UpdateApplicationComment(ctx context.Context, userID uuid.UUID, update ApplicationCommentUpdate) (*ApplicationComment, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.getVisibleComment(ctx, user, update.ApplicationID, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.AuthorID != user.ID {
+		return nil, ErrForbidden
+	}
+
+	if update.Text != nil {
+		comment.Text = *update.Text
+	}
+
+	if update.Internal != nil {
+		if *update.Internal && !user.Role.IsStaff() {
+			return nil, ErrForbidden
+		}
+		comment.Internal = *update.Internal
+	}
+
+	comment.UpdatedAt = time.Now().UTC()
+
+	err = s.repo.UpdateApplicationComment(ctx, *comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationComment(ctx, comment.ID)
+}
+
+// DeleteApplicationComment removes a comment. The author and moderators may do it.
+func (s *Service) DeleteApplicationComment(ctx context.Context, userID, applicationID, commentID uuid.UUID) error {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	comment, err := s.getVisibleComment(ctx, user, applicationID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.AuthorID != user.ID && user.Role != UserRoleModerator {
+		return ErrForbidden
+	}
+
+	return s.repo.DeleteApplicationComment(ctx, comment.ID, time.Now().UTC())
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Config holds the settings of the service from the configuration of the application.
+type Config struct {
+	// DispatchStrategy is the name of the strategy assigning new applications, like "least_open".
+	// Empty means that new applications wait for moderators.
+	DispatchStrategy string
+	// OnDutyWorker is the worker picked by the "on_duty" strategy.
+	OnDutyWorker *uuid.UUID
+
+	// ReopenWindow and DuplicateWindow fall back to the defaults when not set.
+	ReopenWindow    time.Duration
+	DuplicateWindow time.Duration
+	// ScheduleLocation is the time zone of working hours, UTC when not set.
+	ScheduleLocation *time.Location
+}
+
+// NewConfiguredService creates the service with the settings of the configuration.
+// It fails on an unknown dispatch strategy, so a typo in the configuration stops the start.
+func NewConfiguredService(repo Repo, blobs BlobStore, cfg Config) (*Service, error) {
+	srv := NewService(repo, blobs).
+		WithReopenWindow(cfg.ReopenWindow).
+		WithDuplicateWindow(cfg.DuplicateWindow).
+		WithScheduleLocation(cfg.ScheduleLocation)
+
+	if cfg.DispatchStrategy == "" {
+		return srv, nil
+	}
+
+	strategy, err := NewDispatchStrategy(cfg.DispatchStrategy, cfg.OnDutyWorker)
+	if err != nil {
+		return nil, err
+	}
+
+	return srv.WithDispatchStrategy(strategy), nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronScheduleErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{name: "too few fields", expr: "0 0 * *"},
+		{name: "too many fields", expr: "0 0 * * * *"},
+		{name: "minute out of range", expr: "60 0 * * *"},
+		{name: "hour out of range", expr: "0 24 * * *"},
+		{name: "zero day of month", expr: "0 0 0 * *"},
+		{name: "month out of range", expr: "0 0 1 13 *"},
+		{name: "weekday out of range", expr: "0 0 * * 8"},
+		{name: "reversed range", expr: "0 0 10-5 * *"},
+		{name: "zero step", expr: "*/0 * * * *"},
+		{name: "not a number", expr: "a 0 * * *"},
+		{name: "empty list item", expr: "0, 0 * * *"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCronSchedule(tt.expr)
+			if err == nil {
+				t.Errorf("ParseCronSchedule(%q) succeeded, want an error", tt.expr)
+			}
+		})
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	date := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		expr  string
+		after time.Time
+		want  time.Time
+	}{
+		{
+			name:  "every minute",
+			expr:  "* * * * *",
+			after: date(2024, time.March, 10, 12, 30),
+			want:  date(2024, time.March, 10, 12, 31),
+		},
+		{
+			name:  "seconds are truncated",
+			expr:  "* * * * *",
+			after: date(2024, time.March, 10, 12, 30).Add(45 * time.Second),
+			want:  date(2024, time.March, 10, 12, 31),
+		},
+		{
+			name:  "daily later the same day",
+			expr:  "0 9 * * *",
+			after: date(2024, time.March, 10, 8, 0),
+			want:  date(2024, time.March, 10, 9, 0),
+		},
+		{
+			name:  "daily the next day",
+			expr:  "0 9 * * *",
+			after: date(2024, time.March, 10, 9, 0),
+			want:  date(2024, time.March, 11, 9, 0),
+		},
+		{
+			name:  "minute step",
+			expr:  "*/15 * * * *",
+			after: date(2024, time.March, 10, 12, 16),
+			want:  date(2024, time.March, 10, 12, 30),
+		},
+		{
+			name:  "range with step",
+			expr:  "0 1-10/3 * * *",
+			after: date(2024, time.March, 10, 4, 0),
+			want:  date(2024, time.March, 10, 7, 0),
+		},
+		{
+			name:  "list",
+			expr:  "0 0 1,15 * *",
+			after: date(2024, time.March, 2, 0, 0),
+			want:  date(2024, time.March, 15, 0, 0),
+		},
+		{
+			name:  "first day of the next month",
+			expr:  "0 0 1 * *",
+			after: date(2024, time.March, 1, 0, 0),
+			want:  date(2024, time.April, 1, 0, 0),
+		},
+		{
+			name:  "next year",
+			expr:  "0 0 1 1 *",
+			after: date(2024, time.March, 1, 0, 0),
+			want:  date(2025, time.January, 1, 0, 0),
+		},
+		{
+			name:  "leap day",
+			expr:  "0 0 29 2 *",
+			after: date(2024, time.March, 1, 0, 0),
+			want:  date(2028, time.February, 29, 0, 0),
+		},
+		{
+			name:  "weekday",
+			expr:  "0 8 * * 1",
+			after: date(2024, time.March, 10, 0, 0), // Sunday
+			want:  date(2024, time.March, 11, 8, 0),
+		},
+		{
+			name:  "sunday as 7",
+			expr:  "0 8 * * 7",
+			after: date(2024, time.March, 11, 0, 0),
+			want:  date(2024, time.March, 17, 8, 0),
+		},
+		{
+			name:  "both day fields restricted match either",
+			expr:  "0 0 13 * 5",
+			after: date(2024, time.March, 10, 0, 0),
+			want:  date(2024, time.March, 13, 0, 0),
+		},
+		{
+			name:  "day of month step does not restrict the weekday",
+			expr:  "0 0 */2 * 1",
+			after: date(2024, time.March, 10, 0, 0), // Sunday, March 11 is Monday
+			want:  date(2024, time.March, 11, 0, 0),
+		},
+		{
+			name:  "day of month step does not match other weekdays",
+			expr:  "0 0 */2 * 1",
+			after: date(2024, time.March, 11, 0, 0),
+			want:  date(2024, time.March, 18, 0, 0),
+		},
+		{
+			name:  "weekday step does not restrict the day of month",
+			expr:  "0 0 15 * */2",
+			after: date(2024, time.March, 10, 0, 0),
+			want:  date(2024, time.March, 15, 0, 0),
+		},
+		{
+			name:  "never fires",
+			expr:  "0 0 30 2 *",
+			after: date(2024, time.March, 1, 0, 0),
+			want:  time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCronSchedule(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCronSchedule(%q): %v", tt.expr, err)
+			}
+
+			got := schedule.Next(tt.after)
+			if !got.Equal(tt.want) {
+				t.Errorf("Next(%s) of %q = %s, want %s", tt.after, tt.expr, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DispatchCandidate is a worker qualified for an application.
+type DispatchCandidate struct {
+	WorkerID         uuid.UUID
+	OpenApplications int
+	LastAssignedAt   *time.Time
+}
+
+// DispatchStrategy picks a performer for a new application among qualified workers.
+// It returns nil when nobody fits, the application then stays in the moderation queue.
+type DispatchStrategy interface {
+	Pick(candidates []DispatchCandidate) (*DispatchCandidate, string)
+}
+
+const (
+	DispatchRoundRobin = "round_robin"
+	DispatchLeastOpen  = "least_open"
+	DispatchOnDuty     = "on_duty"
+)
+
+// NewDispatchStrategy creates a strategy by its name from the configuration.
+// onDutyWorker is required only for the on_duty strategy.
+func NewDispatchStrategy(name string, onDutyWorker *uuid.UUID) (DispatchStrategy, error) {
+	switch name {
+	case DispatchRoundRobin:
+		return RoundRobinStrategy{}, nil
+	case DispatchLeastOpen:
+		return LeastOpenStrategy{}, nil
+	case DispatchOnDuty:
+		if onDutyWorker == nil {
+			return nil, fmt.Errorf("dispatch strategy %q: on duty worker is not set", name)
+		}
+		return OnDutyStrategy{WorkerID: *onDutyWorker}, nil
+	default:
+		return nil, fmt.Errorf("unknown dispatch strategy %q", name)
+	}
+}
+
+// RoundRobinStrategy picks the worker who has not been assigned for the longest time.
+type RoundRobinStrategy struct{}
+
+func (RoundRobinStrategy) Pick(candidates []DispatchCandidate) (*DispatchCandidate, string) {
+	var picked *DispatchCandidate
+
+	for i := range candidates {
+		if picked == nil || assignedEarlier(&candidates[i], picked) {
+			picked = &candidates[i]
+		}
+	}
+
+	if picked == nil {
+		return nil, ""
+	}
+
+	if picked.LastAssignedAt == nil {
+		return picked, "round robin: worker has never been assigned"
+	}
+
+	return picked, fmt.Sprintf("round robin: worker was last assigned at %s", picked.LastAssignedAt.UTC().Format(time.RFC3339))
+}
+
+func assignedEarlier(a, b *DispatchCandidate) bool {
+	if a.LastAssignedAt == nil {
+		return b.LastAssignedAt != nil
+	}
+
+	if b.LastAssignedAt == nil {
+		return false
+	}
+
+	return a.LastAssignedAt.Before(*b.LastAssignedAt)
+}
+
+// LeastOpenStrategy picks the worker with the fewest open applications.
+type LeastOpenStrategy struct{}
+
+func (LeastOpenStrategy) Pick(candidates []DispatchCandidate) (*DispatchCandidate, string) {
+	var picked *DispatchCandidate
+
+	for i := range candidates {
+		if picked == nil || candidates[i].OpenApplications < picked.OpenApplications {
+			picked = &candidates[i]
+		}
+	}
+
+	if picked == nil {
+		return nil, ""
+	}
+
+	return picked, fmt.Sprintf("least open: worker has %d open applications", picked.OpenApplications)
+}
+
+// OnDutyStrategy always picks the same worker if the worker is qualified for the application.
+type OnDutyStrategy struct {
+	WorkerID uuid.UUID
+}
+
+func (s OnDutyStrategy) Pick(candidates []DispatchCandidate) (*DispatchCandidate, string) {
+	for i := range candidates {
+		if candidates[i].WorkerID == s.WorkerID {
+			return &candidates[i], "on duty worker"
+		}
+	}
+
+	return nil, ""
+}
+
+// WithDispatchStrategy returns the service that assigns new applications automatically.
+func (s *Service) WithDispatchStrategy(strategy DispatchStrategy) *Service {
+	srv := &Service{}
+	*srv = *s
+	srv.dispatch = strategy
+	return srv
+}
+
+// dispatchApplication assigns a qualified worker to the new application.
+// Without a strategy or suitable workers the application is left for moderators.
+func (s *Service) dispatchApplication(ctx context.Context, appl Application) error {
+	if s.dispatch == nil || appl.PerformerID != nil {
+		return nil
+	}
+
+	candidates, err := s.repo.ListDispatchCandidates(ctx, appl.Type, appl.SubType)
+	if err != nil {
+		return err
+	}
+
+	candidates, err = s.availableCandidates(ctx, candidates)
+	if err != nil {
+		return err
+	}
+
+	picked, reason := s.dispatch.Pick(candidates)
+	if picked == nil {
+		return nil
+	}
+
+	err = s.repo.UpdateApplication(ctx, Application{ID: appl.ID, PerformerID: &picked.WorkerID})
+	if err != nil {
+		return err
+	}
+
+	event := newApplicationEvent(appl.ID, nil, ApplEventFieldPerformerID, nil, uuidToString(&picked.WorkerID))
+	event.Comment = &reason
+
+	return s.repo.CreateApplicationEvents(ctx, []ApplicationEvent{event})
+}
+
+// availableCandidates drops the workers who are absent today. Weekly hours are not checked,
+// as on manual assignment, so applications created after hours are dispatched too.
+func (s *Service) availableCandidates(ctx context.Context, candidates []DispatchCandidate) ([]DispatchCandidate, error) {
+	now := time.Now()
+	available := make([]DispatchCandidate, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		reason, err := s.workerAbsence(ctx, candidate.WorkerID, now)
+		if err != nil {
+			return nil, err
+		}
+
+		if reason == "" {
+			available = append(available, candidate)
+		}
+	}
+
+	return available, nil
+}
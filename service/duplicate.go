@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultDuplicateWindow is how far back open applications are compared with a new one.
+	DefaultDuplicateWindow = 72 * time.Hour
+	// DuplicateSimilarity is the minimal similarity of texts, from 0 to 1, to consider applications duplicates.
+	DuplicateSimilarity = 0.4
+	// DuplicateCandidatesLimit is the maximum number of candidates returned for a new application.
+	DuplicateCandidatesLimit = 5
+)
+
+// DuplicateFilter describes open applications that may duplicate the application:
+// the same type, subtype and premises and a similar text.
+type DuplicateFilter struct {
+	ExcludeID     uuid.UUID
+	Type          uuid.UUID
+	SubType       uuid.UUID
+	BuildingID    *uuid.UUID
+	ApartmentID   *uuid.UUID
+	Text          string
+	CreatedAfter  time.Time
+	MinSimilarity float64
+	Limit         int
+}
+
+// WithDuplicateWindow returns the service that looks for duplicates among applications created within the window.
+func (s *Service) WithDuplicateWindow(window time.Duration) *Service {
+	srv := &Service{}
+	*srv = *s
+	srv.duplicateWindow = window
+	return srv
+}
+
+func (s *Service) getDuplicateWindow() time.Duration {
+	if s.duplicateWindow <= 0 {
+		return DefaultDuplicateWindow
+	}
+
+	return s.duplicateWindow
+}
+
+// findDuplicates returns open applications similar to the application, the most similar first.
+// Only moderators see the candidates in full, others get the id, the status and the creation time,
+// so residents do not read applications of their neighbours.
+func (s *Service) findDuplicates(ctx context.Context, appl Application) ([]*Application, error) {
+	duplicates, err := s.repo.ListDuplicateCandidates(ctx, DuplicateFilter{
+		ExcludeID:     appl.ID,
+		Type:          appl.Type,
+		SubType:       appl.SubType,
+		BuildingID:    appl.BuildingID,
+		ApartmentID:   appl.ApartmentID,
+		Text:          appl.Text,
+		CreatedAfter:  appl.CreatedAt.Add(-s.getDuplicateWindow()),
+		MinSimilarity: DuplicateSimilarity,
+		Limit:         DuplicateCandidatesLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	creator, err := s.repo.GetUser(ctx, appl.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if creator.Role == UserRoleModerator {
+		return duplicates, nil
+	}
+
+	for i, d := range duplicates {
+		duplicates[i] = &Application{ID: d.ID, Status: d.Status, CreatedAt: d.CreatedAt}
+	}
+
+	return duplicates, nil
+}
+
+// MarkDuplicate lets a moderator close the application as a duplicate of the original one.
+func (s *Service) MarkDuplicate(ctx context.Context, userID, applicationID, originalID uuid.UUID) (*Application, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role != UserRoleModerator {
+		return nil, ErrForbidden
+	}
+
+	current, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := s.repo.GetApplication(ctx, originalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// link to the root of the chain, so every duplicate points to the application in work
+	if original.DuplicateOfID != nil {
+		original, err = s.repo.GetApplication(ctx, *original.DuplicateOfID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if original.ID == current.ID {
+		return nil, &TransitionError{From: current.Status, To: ApplStatusRejected, Role: user.Role, Reason: "application can not duplicate itself"}
+	}
+
+	// the duplicate is closed in favour of the original, so somebody has to work on the original
+	if !original.Status.IsOpen() {
+		return nil, &TransitionError{From: current.Status, To: ApplStatusRejected, Role: user.Role,
+			Reason: "original application is " + string(original.Status)}
+	}
+
+	update := Application{ID: current.ID, Status: ApplStatusRejected, DuplicateOfID: &original.ID}
+
+	err = s.checkApplicationTransition(current, update, user)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.updateApplication(ctx, user.ID, current, update, toPoint("duplicate of "+original.ID.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplication(ctx, current.ID)
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"bio/pagination"
+
+	"github.com/google/uuid"
+)
+
+type ApplicationEventField string
+
+const (
+	ApplEventFieldStatus        ApplicationEventField = "status"
+	ApplEventFieldPerformerID   ApplicationEventField = "performer_id"
+	ApplEventFieldPerformerTime ApplicationEventField = "performer_time"
+	ApplEventFieldPriority      ApplicationEventField = "priority"
+	ApplEventFieldDuplicateOf   ApplicationEventField = "duplicate_of"
+)
+
+// ApplicationEvent is a single change of an application field.
+type ApplicationEvent struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	CreatedAt     time.Time
+	// AuthorID is nil for changes made by the system, e.g. by the dispatcher.
+	AuthorID *uuid.UUID
+
+	Field    ApplicationEventField
+	OldValue *string
+	NewValue *string
+	// Comment explains the change, e.g. the reason of a declined assignment.
+	Comment *string
+}
+
+type ApplicationEventFilter struct {
+	ApplicationID uuid.UUID
+
+	Pagination pagination.Pagination
+}
+
+// ListApplicationEvents returns the history of the application to its creator, its performer or a moderator.
+func (s *Service) ListApplicationEvents(ctx context.Context, userID uuid.UUID, filter ApplicationEventFilter) ([]ApplicationEvent, int, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	appl, err := s.repo.GetApplication(ctx, filter.ApplicationID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !isParticipant(appl, user) {
+		return nil, 0, ErrForbidden
+	}
+
+	return s.repo.ListApplicationEvents(ctx, filter)
+}
+
+func newApplicationEvent(applicationID uuid.UUID, authorID *uuid.UUID, field ApplicationEventField, oldValue, newValue *string) ApplicationEvent {
+	return ApplicationEvent{
+		ID:            uuid.New(),
+		ApplicationID: applicationID,
+		CreatedAt:     time.Now().UTC(),
+		AuthorID:      authorID,
+		Field:         field,
+		OldValue:      oldValue,
+		NewValue:      newValue,
+	}
+}
+
+// applicationEvents compares the current application with the update
+// and returns an event for every field that is changed.
+func applicationEvents(authorID uuid.UUID, current *Application, update Application) []ApplicationEvent {
+	events := []ApplicationEvent{}
+
+	add := func(field ApplicationEventField, oldValue, newValue *string) {
+		events = append(events, newApplicationEvent(current.ID, &authorID, field, oldValue, newValue))
+	}
+
+	if update.Status != "" && update.Status != current.Status {
+		add(ApplEventFieldStatus, toPoint(string(current.Status)), toPoint(string(update.Status)))
+	}
+
+	if update.Priority != "" && update.Priority != current.Priority {
+		add(ApplEventFieldPriority, toPoint(string(current.Priority)), toPoint(string(update.Priority)))
+	}
+
+	if update.PerformerID != nil && (current.PerformerID == nil || *current.PerformerID != *update.PerformerID) {
+		add(ApplEventFieldPerformerID, uuidToString(current.PerformerID), uuidToString(update.PerformerID))
+	}
+
+	if update.PerformerTime != nil && (current.PerformerTime == nil || !current.PerformerTime.Equal(*update.PerformerTime)) {
+		add(ApplEventFieldPerformerTime, timeToString(current.PerformerTime), timeToString(update.PerformerTime))
+	}
+
+	if update.DuplicateOfID != nil && (current.DuplicateOfID == nil || *current.DuplicateOfID != *update.DuplicateOfID) {
+		add(ApplEventFieldDuplicateOf, uuidToString(current.DuplicateOfID), uuidToString(update.DuplicateOfID))
+	}
+
+	return events
+}
+
+func toPoint[T any](t T) *T {
+	return &t
+}
+
+func uuidToString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	return toPoint(id.String())
+}
+
+func timeToString(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	return toPoint(t.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,18 @@
+package service
+
+// checkApplicationFields validates the custom fields against the schema of the application type.
+// Types without a schema do not accept custom fields.
+func checkApplicationFields(applType *ApplicationType, fields map[string]interface{}) error {
+	if applType.FieldsSchema == nil {
+		if len(fields) != 0 {
+			return &FieldsError{Reason: "application type has no custom fields"}
+		}
+		return nil
+	}
+
+	if fields == nil {
+		fields = map[string]interface{}{}
+	}
+
+	return validateJSONSchema(applType.FieldsSchema, fields, "")
+}
@@ -0,0 +1,22 @@
+package service
+
+// GeoPoint is a WGS 84 point.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func (p GeoPoint) IsValid() bool {
+	return p.Latitude >= -90 && p.Latitude <= 90 && p.Longitude >= -180 && p.Longitude <= 180
+}
+
+// GeoBox is the area between the south-west and the north-east corners.
+type GeoBox struct {
+	SouthWest GeoPoint
+	NorthEast GeoPoint
+}
+
+func (b GeoBox) IsValid() bool {
+	return b.SouthWest.IsValid() && b.NorthEast.IsValid() &&
+		b.SouthWest.Latitude <= b.NorthEast.Latitude && b.SouthWest.Longitude <= b.NorthEast.Longitude
+}
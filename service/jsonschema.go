@@ -0,0 +1,374 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+)
+
+// FieldsError is returned when the custom fields of an application do not match the schema of its type.
+type FieldsError struct {
+	// Path is the JSON pointer of the invalid value, empty for the whole object.
+	Path   string
+	Reason string
+}
+
+func (e *FieldsError) Error() string {
+	if e.Path == "" {
+		return "fields: " + e.Reason
+	}
+
+	return fmt.Sprintf("fields %s: %s", e.Path, e.Reason)
+}
+
+// validateJSONSchema checks the decoded JSON value against the JSON Schema.
+// It supports the keywords used to describe flat structured data: type, enum, const,
+// properties, required, additionalProperties, items, minItems, maxItems,
+// minimum, maximum, exclusiveMinimum, exclusiveMaximum, minLength, maxLength and pattern.
+// checkJSONSchema rejects schemas with other keywords except annotations, so a saved schema
+// never validates less than it declares.
+func validateJSONSchema(schema interface{}, value interface{}, path string) error {
+	switch s := schema.(type) {
+	case nil:
+		return nil
+	case bool:
+		if !s {
+			return &FieldsError{Path: path, Reason: "value is not allowed"}
+		}
+		return nil
+	case map[string]interface{}:
+		return validateSchemaObject(s, value, path)
+	default:
+		return &FieldsError{Path: path, Reason: "schema is malformed"}
+	}
+}
+
+func validateSchemaObject(schema map[string]interface{}, value interface{}, path string) error {
+	if types, ok := schema["type"]; ok && !matchesSchemaType(types, value) {
+		return &FieldsError{Path: path, Reason: fmt.Sprintf("has to be of type %v", types)}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, option := range enum {
+			if reflect.DeepEqual(option, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return &FieldsError{Path: path, Reason: "is not one of the allowed values"}
+		}
+	}
+
+	if constant, ok := schema["const"]; ok && !reflect.DeepEqual(constant, value) {
+		return &FieldsError{Path: path, Reason: "is not the allowed value"}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return validateSchemaProperties(schema, v, path)
+	case []interface{}:
+		return validateSchemaItems(schema, v, path)
+	case float64:
+		return validateSchemaNumber(schema, v, path)
+	case string:
+		return validateSchemaString(schema, v, path)
+	}
+
+	return nil
+}
+
+func matchesSchemaType(types interface{}, value interface{}) bool {
+	switch t := types.(type) {
+	case string:
+		return matchesType(t, value)
+	case []interface{}:
+		for _, name := range t {
+			if name, ok := name.(string); ok && matchesType(name, value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func matchesType(name string, value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return name == "null"
+	case bool:
+		return name == "boolean"
+	case string:
+		return name == "string"
+	case float64:
+		return name == "number" || (name == "integer" && v == math.Trunc(v))
+	case []interface{}:
+		return name == "array"
+	case map[string]interface{}:
+		return name == "object"
+	}
+
+	return false
+}
+
+func validateSchemaProperties(schema map[string]interface{}, value map[string]interface{}, path string) error {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			name, _ := name.(string)
+			if _, ok := value[name]; !ok {
+				return &FieldsError{Path: path + "/" + name, Reason: "is required"}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	// names are sorted to report the same error for the same object
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		propertySchema, ok := properties[name]
+		if !ok {
+			additional, defined := schema["additionalProperties"]
+			if !defined {
+				continue
+			}
+			propertySchema = additional
+		}
+
+		err := validateJSONSchema(propertySchema, value[name], path+"/"+name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSchemaItems(schema map[string]interface{}, value []interface{}, path string) error {
+	if min, ok := schema["minItems"].(float64); ok && float64(len(value)) < min {
+		return &FieldsError{Path: path, Reason: fmt.Sprintf("has to contain at least %v items", min)}
+	}
+
+	if max, ok := schema["maxItems"].(float64); ok && float64(len(value)) > max {
+		return &FieldsError{Path: path, Reason: fmt.Sprintf("has to contain at most %v items", max)}
+	}
+
+	items, ok := schema["items"]
+	if !ok {
+		return nil
+	}
+
+	for i, item := range value {
+		err := validateJSONSchema(items, item, fmt.Sprintf("%s/%d", path, i))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSchemaNumber(schema map[string]interface{}, value float64, path string) error {
+	if min, ok := schema["minimum"].(float64); ok && value < min {
+		return &FieldsError{Path: path, Reason: fmt.Sprintf("has to be at least %v", min)}
+	}
+
+	if max, ok := schema["maximum"].(float64); ok && value > max {
+		return &FieldsError{Path: path, Reason: fmt.Sprintf("has to be at most %v", max)}
+	}
+
+	if min, ok := schema["exclusiveMinimum"].(float64); ok && value <= min {
+		return &FieldsError{Path: path, Reason: fmt.Sprintf("has to be greater than %v", min)}
+	}
+
+	if max, ok := schema["exclusiveMaximum"].(float64); ok && value >= max {
+		return &FieldsError{Path: path, Reason: fmt.Sprintf("has to be less than %v", max)}
+	}
+
+	return nil
+}
+
+func validateSchemaString(schema map[string]interface{}, value string, path string) error {
+	length := float64(utf8.RuneCountInString(value))
+
+	if min, ok := schema["minLength"].(float64); ok && length < min {
+		return &FieldsError{Path: path, Reason: fmt.Sprintf("has to be at least %v characters long", min)}
+	}
+
+	if max, ok := schema["maxLength"].(float64); ok && length > max {
+		return &FieldsError{Path: path, Reason: fmt.Sprintf("has to be at most %v characters long", max)}
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return &FieldsError{Path: path, Reason: "schema pattern is malformed"}
+		}
+		if !re.MatchString(value) {
+			return &FieldsError{Path: path, Reason: "does not match " + pattern}
+		}
+	}
+
+	return nil
+}
+
+// schemaKeywords are the keywords a schema may use: the ones validateJSONSchema supports
+// and the annotations that do not affect validation.
+var schemaKeywords = map[string]bool{
+	"type": true, "enum": true, "const": true,
+	"properties": true, "required": true, "additionalProperties": true,
+	"items": true, "minItems": true, "maxItems": true,
+	"minimum": true, "maximum": true, "exclusiveMinimum": true, "exclusiveMaximum": true,
+	"minLength": true, "maxLength": true, "pattern": true,
+	"$schema": true, "$comment": true, "title": true, "description": true, "default": true, "examples": true,
+}
+
+// schemaTypes are the type names of JSON Schema.
+var schemaTypes = map[string]bool{
+	"null": true, "boolean": true, "string": true, "number": true, "integer": true, "array": true, "object": true,
+}
+
+// checkJSONSchema makes sure that the schema uses only the supported keywords and uses them correctly:
+// known types, compilable patterns and consistent bounds, so it does not fail later on the fields of applications.
+func checkJSONSchema(schema interface{}) bool {
+	switch s := schema.(type) {
+	case bool:
+		return true
+	case map[string]interface{}:
+		return checkSchemaObject(s)
+	}
+
+	return false
+}
+
+func checkSchemaObject(schema map[string]interface{}) bool {
+	for keyword := range schema {
+		if !schemaKeywords[keyword] {
+			return false
+		}
+	}
+
+	if types, ok := schema["type"]; ok && !checkSchemaType(types) {
+		return false
+	}
+
+	if enum, ok := schema["enum"]; ok {
+		if _, ok := enum.([]interface{}); !ok {
+			return false
+		}
+	}
+
+	if properties, ok := schema["properties"]; ok {
+		properties, ok := properties.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for _, propertySchema := range properties {
+			if !checkJSONSchema(propertySchema) {
+				return false
+			}
+		}
+	}
+
+	if required, ok := schema["required"]; ok {
+		required, ok := required.([]interface{})
+		if !ok {
+			return false
+		}
+
+		for _, name := range required {
+			if _, ok := name.(string); !ok {
+				return false
+			}
+		}
+	}
+
+	for _, keyword := range []string{"additionalProperties", "items"} {
+		if subSchema, ok := schema[keyword]; ok && !checkJSONSchema(subSchema) {
+			return false
+		}
+	}
+
+	for _, bounds := range [][2]string{{"minItems", "maxItems"}, {"minLength", "maxLength"}} {
+		if !checkSchemaBounds(schema, bounds[0], bounds[1], true) {
+			return false
+		}
+	}
+
+	for _, bounds := range [][2]string{{"minimum", "maximum"}, {"exclusiveMinimum", "exclusiveMaximum"}} {
+		if !checkSchemaBounds(schema, bounds[0], bounds[1], false) {
+			return false
+		}
+	}
+
+	if pattern, ok := schema["pattern"]; ok {
+		pattern, ok := pattern.(string)
+		if !ok {
+			return false
+		}
+
+		_, err := regexp.Compile(pattern)
+		if err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func checkSchemaType(types interface{}) bool {
+	switch t := types.(type) {
+	case string:
+		return schemaTypes[t]
+	case []interface{}:
+		if len(t) == 0 {
+			return false
+		}
+
+		for _, name := range t {
+			if name, ok := name.(string); !ok || !schemaTypes[name] {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// checkSchemaBounds makes sure that the bounds are numbers and the lower one does not exceed the upper one.
+// Counts have to be non-negative integers.
+func checkSchemaBounds(schema map[string]interface{}, minKeyword, maxKeyword string, isCount bool) bool {
+	var bounds []float64
+
+	for _, keyword := range []string{minKeyword, maxKeyword} {
+		raw, ok := schema[keyword]
+		if !ok {
+			continue
+		}
+
+		bound, ok := raw.(float64)
+		if !ok || (isCount && (bound < 0 || bound != math.Trunc(bound))) {
+			return false
+		}
+
+		bounds = append(bounds, bound)
+	}
+
+	_, hasMin := schema[minKeyword]
+	_, hasMax := schema[maxKeyword]
+
+	return !(hasMin && hasMax) || bounds[0] <= bounds[1]
+}
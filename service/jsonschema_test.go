@@ -0,0 +1,158 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func decodeJSON(t *testing.T, raw string) interface{} {
+	t.Helper()
+
+	var v interface{}
+
+	err := json.Unmarshal([]byte(raw), &v)
+	if err != nil {
+		t.Fatalf("decode %s: %v", raw, err)
+	}
+
+	return v
+}
+
+func TestValidateJSONSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		value  string
+		// path is the path of the expected error, "-" means no error.
+		path string
+	}{
+		{name: "true schema", schema: `true`, value: `1`, path: "-"},
+		{name: "false schema", schema: `false`, value: `1`, path: ""},
+		{name: "type matches", schema: `{"type": "string"}`, value: `"a"`, path: "-"},
+		{name: "type mismatch", schema: `{"type": "string"}`, value: `1`, path: ""},
+		{name: "type list", schema: `{"type": ["string", "null"]}`, value: `null`, path: "-"},
+		{name: "integer", schema: `{"type": "integer"}`, value: `2`, path: "-"},
+		{name: "fraction is not integer", schema: `{"type": "integer"}`, value: `2.5`, path: ""},
+		{name: "enum matches", schema: `{"enum": ["a", 1]}`, value: `1`, path: "-"},
+		{name: "enum mismatch", schema: `{"enum": ["a", 1]}`, value: `"b"`, path: ""},
+		{name: "const mismatch", schema: `{"const": "a"}`, value: `"b"`, path: ""},
+		{
+			name:   "required property",
+			schema: `{"type": "object", "required": ["floor"]}`,
+			value:  `{}`,
+			path:   "/floor",
+		},
+		{
+			name:   "property schema",
+			schema: `{"properties": {"floor": {"type": "integer", "minimum": 1}}}`,
+			value:  `{"floor": 0}`,
+			path:   "/floor",
+		},
+		{
+			name:   "additional properties allowed by default",
+			schema: `{"properties": {"floor": {"type": "integer"}}}`,
+			value:  `{"room": "a"}`,
+			path:   "-",
+		},
+		{
+			name:   "additional properties forbidden",
+			schema: `{"properties": {"floor": {"type": "integer"}}, "additionalProperties": false}`,
+			value:  `{"room": "a"}`,
+			path:   "/room",
+		},
+		{
+			name:   "first invalid property in name order",
+			schema: `{"additionalProperties": {"type": "string"}}`,
+			value:  `{"b": 1, "a": 2}`,
+			path:   "/a",
+		},
+		{name: "min items", schema: `{"minItems": 2}`, value: `[1]`, path: ""},
+		{name: "max items", schema: `{"maxItems": 1}`, value: `[1, 2]`, path: ""},
+		{name: "items", schema: `{"items": {"type": "string"}}`, value: `["a", 1]`, path: "/1"},
+		{name: "maximum", schema: `{"maximum": 5}`, value: `6`, path: ""},
+		{name: "exclusive minimum", schema: `{"exclusiveMinimum": 5}`, value: `5`, path: ""},
+		{name: "exclusive maximum", schema: `{"exclusiveMaximum": 5}`, value: `4`, path: "-"},
+		{name: "min length counts characters", schema: `{"minLength": 3}`, value: `"абв"`, path: "-"},
+		{name: "max length", schema: `{"maxLength": 2}`, value: `"abc"`, path: ""},
+		{name: "pattern matches", schema: `{"pattern": "^[0-9]+$"}`, value: `"123"`, path: "-"},
+		{name: "pattern mismatch", schema: `{"pattern": "^[0-9]+$"}`, value: `"12a"`, path: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateJSONSchema(decodeJSON(t, tt.schema), decodeJSON(t, tt.value), "")
+
+			if tt.path == "-" {
+				if err != nil {
+					t.Errorf("validateJSONSchema(%s, %s) = %v, want no error", tt.schema, tt.value, err)
+				}
+				return
+			}
+
+			var fieldsErr *FieldsError
+			if !errors.As(err, &fieldsErr) {
+				t.Fatalf("validateJSONSchema(%s, %s) = %v, want FieldsError", tt.schema, tt.value, err)
+			}
+
+			if fieldsErr.Path != tt.path {
+				t.Errorf("validateJSONSchema(%s, %s) path = %q, want %q", tt.schema, tt.value, fieldsErr.Path, tt.path)
+			}
+		})
+	}
+}
+
+func TestCheckJSONSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		valid  bool
+	}{
+		{name: "empty", schema: `{}`, valid: true},
+		{name: "boolean", schema: `true`, valid: true},
+		{name: "not an object", schema: `"string"`, valid: false},
+		{
+			name: "supported keywords",
+			schema: `{"type": "object", "required": ["floor"], "additionalProperties": false,
+				"properties": {
+					"floor": {"type": "integer", "minimum": 1, "maximum": 30},
+					"meter": {"type": "string", "pattern": "^[0-9]{8}$", "minLength": 8, "maxLength": 8},
+					"rooms": {"type": "array", "items": {"enum": ["kitchen", "bath"]}, "minItems": 1, "maxItems": 2},
+					"kind": {"const": "cold", "title": "Kind", "description": "water", "default": "cold"}
+				}}`,
+			valid: true,
+		},
+		{name: "unknown type", schema: `{"type": "date"}`, valid: false},
+		{name: "empty type list", schema: `{"type": []}`, valid: false},
+		{name: "unknown type in list", schema: `{"type": ["string", "date"]}`, valid: false},
+		{name: "enum is not a list", schema: `{"enum": "a"}`, valid: false},
+		{name: "required is not a list of names", schema: `{"required": [1]}`, valid: false},
+		{name: "invalid property schema", schema: `{"properties": {"a": {"type": "date"}}}`, valid: false},
+		{name: "invalid items schema", schema: `{"items": 1}`, valid: false},
+		{name: "pattern does not compile", schema: `{"pattern": "("}`, valid: false},
+		{name: "pattern is not a string", schema: `{"pattern": 1}`, valid: false},
+		{name: "negative count", schema: `{"minLength": -1}`, valid: false},
+		{name: "fractional count", schema: `{"maxItems": 1.5}`, valid: false},
+		{name: "reversed counts", schema: `{"minItems": 3, "maxItems": 2}`, valid: false},
+		{name: "reversed bounds", schema: `{"minimum": 3, "maximum": 2}`, valid: false},
+		{name: "negative bounds", schema: `{"minimum": -3, "maximum": -2}`, valid: true},
+		{name: "bound is not a number", schema: `{"minimum": "1"}`, valid: false},
+		{name: "format is not supported", schema: `{"type": "string", "format": "email"}`, valid: false},
+		{name: "combinators are not supported", schema: `{"oneOf": [{"type": "string"}]}`, valid: false},
+		{name: "references are not supported", schema: `{"$ref": "#/definitions/a"}`, valid: false},
+		{
+			name:   "nested unsupported keyword",
+			schema: `{"properties": {"a": {"dependentRequired": {"a": ["b"]}}}}`,
+			valid:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkJSONSchema(decodeJSON(t, tt.schema))
+			if got != tt.valid {
+				t.Errorf("checkJSONSchema(%s) = %v, want %v", tt.schema, got, tt.valid)
+			}
+		})
+	}
+}
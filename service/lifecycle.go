@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultReopenWindow is the time after completion during which an application may be reopened.
+const DefaultReopenWindow = 14 * 24 * time.Hour
+
+// WithReopenWindow returns the service that allows to reopen applications within the window after completion.
+func (s *Service) WithReopenWindow(window time.Duration) *Service {
+	srv := &Service{}
+	*srv = *s
+	srv.reopenWindow = window
+	return srv
+}
+
+func (s *Service) getReopenWindow() time.Duration {
+	if s.reopenWindow <= 0 {
+		return DefaultReopenWindow
+	}
+
+	return s.reopenWindow
+}
+
+// CancelApplication lets the creator withdraw the application before it is completed.
+func (s *Service) CancelApplication(ctx context.Context, userID, applicationID uuid.UUID, reason *string) (*Application, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.CreatorID != user.ID {
+		return nil, ErrForbidden
+	}
+
+	return s.changeStatus(ctx, user, current, ApplStatusCancelled, reason)
+}
+
+// ReopenApplication returns the completed application into work
+// if the creator or a moderator is not satisfied with the result.
+func (s *Service) ReopenApplication(ctx context.Context, userID, applicationID uuid.UUID, reason *string) (*Application, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role != UserRoleModerator && current.CreatorID != user.ID {
+		return nil, ErrForbidden
+	}
+
+	return s.changeStatus(ctx, user, current, ApplStatusReopened, reason)
+}
+
+func (s *Service) changeStatus(ctx context.Context, user *User, current *Application, status ApplicationStatus, reason *string) (*Application, error) {
+	update := Application{ID: current.ID, Status: status}
+
+	err := s.checkApplicationTransition(current, update, user)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.updateApplication(ctx, user.ID, current, update, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplication(ctx, current.ID)
+}
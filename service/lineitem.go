@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"bio/pagination"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidLineItem is returned for a line item without a material and labour or with negative amounts.
+	ErrInvalidLineItem = errors.New("InvalidLineItem")
+	// ErrLineItemsLocked is returned when line items are changed out of the work on the application.
+	ErrLineItemsLocked = errors.New("line items can be changed only while the application is in progress")
+)
+
+// ApplicationLineItem is a material used or labour spent on the application.
+type ApplicationLineItem struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	AuthorID      uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	// Material is empty for labour only items.
+	Material string
+	Quantity float64
+	// UnitPrice is the price of a unit of the material in kopecks.
+	UnitPrice   int64
+	LabourHours float64
+}
+
+// Cost is the cost of the material in kopecks.
+func (i ApplicationLineItem) Cost() int64 {
+	return int64(math.Round(i.Quantity * float64(i.UnitPrice)))
+}
+
+func (i ApplicationLineItem) isValid() bool {
+	if i.Quantity < 0 || i.UnitPrice < 0 || i.LabourHours < 0 {
+		return false
+	}
+
+	return i.Material != "" || i.LabourHours > 0
+}
+
+// ApplicationLineItemUpdate holds the line item fields to change, nil fields are left as is.
+type ApplicationLineItemUpdate struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+
+	Material    *string
+	Quantity    *float64
+	UnitPrice   *int64
+	LabourHours *float64
+}
+
+type ApplicationLineItemFilter struct {
+	ApplicationID uuid.UUID
+
+	Pagination pagination.Pagination
+}
+
+// ApplicationCosts are the totals of the application line items.
+type ApplicationCosts struct {
+	// MaterialsCost is in kopecks.
+	MaterialsCost int64
+	LabourHours   float64
+}
+
+// CostStat is the total cost of applications grouped by an application type.
+type CostStat struct {
+	ID uuid.UUID
+	// Count is the number of applications with line items.
+	Count int
+	ApplicationCosts
+}
+
+// checkLineItemsEditable makes sure that the user performs the application and the work is in progress.
+func (s *Service) checkLineItemsEditable(ctx context.Context, userID, applicationID uuid.UUID) error {
+	return s.checkPerformerAtWork(ctx, userID, applicationID, ErrLineItemsLocked)
+}
+
+// checkPerformerAtWork makes sure that the user performs the application and the work is in progress,
+// lockedErr is returned when it is not.
+func (s *Service) checkPerformerAtWork(ctx context.Context, userID, applicationID uuid.UUID, lockedErr error) error {
+	appl, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+
+	if appl.PerformerID == nil || *appl.PerformerID != userID {
+		return ErrForbidden
+	}
+
+	if appl.Status != ApplStatusInProgress {
+		return lockedErr
+	}
+
+	return nil
+}
+
+func (s *Service) CreateApplicationLineItem(ctx context.Context, userID uuid.UUID, item ApplicationLineItem) (*ApplicationLineItem, error) {
+	err := s.checkLineItemsEditable(ctx, userID, item.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !item.isValid() {
+		return nil, ErrInvalidLineItem
+	}
+
+	item.AuthorID = userID
+
+	err = s.repo.CreateApplicationLineItem(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationLineItem(ctx, item.ID)
+}
+
+func (s *Service) ListApplicationLineItems(ctx context.Context, filter ApplicationLineItemFilter) ([]ApplicationLineItem, int, error) {
+	_, err := s.repo.GetApplication(ctx, filter.ApplicationID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.ListApplicationLineItems(ctx, filter)
+}
+
+func (s *Service) getApplicationLineItem(ctx context.Context, applicationID, itemID uuid.UUID) (*ApplicationLineItem, error) {
+	item, err := s.repo.GetApplicationLineItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.ApplicationID != applicationID {
+		return nil, ErrNotFound
+	}
+
+	return item, nil
+}
+
+func (s *Service) UpdateApplicationLineItem(ctx context.Context, userID uuid.UUID, update ApplicationLineItemUpdate) (*ApplicationLineItem, error) {
+	err := s.checkLineItemsEditable(ctx, userID, update.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.getApplicationLineItem(ctx, update.ApplicationID, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Material != nil {
+		item.Material = *update.Material
+	}
+
+	if update.Quantity != nil {
+		item.Quantity = *update.Quantity
+	}
+
+	if update.UnitPrice != nil {
+		item.UnitPrice = *update.UnitPrice
+	}
+
+	if update.LabourHours != nil {
+		item.LabourHours = *update.LabourHours
+	}
+
+	if !item.isValid() {
+		return nil, ErrInvalidLineItem
+	}
+
+	item.UpdatedAt = time.Now().UTC()
+
+	err = s.repo.UpdateApplicationLineItem(ctx, *item)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplicationLineItem(ctx, item.ID)
+}
+
+func (s *Service) DeleteApplicationLineItem(ctx context.Context, userID, applicationID, itemID uuid.UUID) error {
+	err := s.checkLineItemsEditable(ctx, userID, applicationID)
+	if err != nil {
+		return err
+	}
+
+	item, err := s.getApplicationLineItem(ctx, applicationID, itemID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.DeleteApplicationLineItem(ctx, item.ID, time.Now().UTC())
+}
+
+// GetCostReport returns the materials cost and the labour hours per application type. Only for moderators.
+func (s *Service) GetCostReport(ctx context.Context, userID uuid.UUID) ([]CostStat, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListApplicationTypeCosts(ctx)
+}
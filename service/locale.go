@@ -0,0 +1,53 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+)
+
+// localePattern matches lowercase language tags like "ru", "en" or "en-gb".
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// normalizeTitles lowercases the locales of the translated titles.
+// It reports false for a malformed locale or an empty title.
+func normalizeTitles(titles map[string]string) (map[string]string, bool) {
+	if titles == nil {
+		return nil, true
+	}
+
+	out := make(map[string]string, len(titles))
+
+	for locale, title := range titles {
+		locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+
+		if !localePattern.MatchString(locale) || strings.TrimSpace(title) == "" {
+			return nil, false
+		}
+		out[locale] = title
+	}
+
+	return out, true
+}
+
+// localizedTitle picks the translation for the first preferred locale that has one.
+// A locale falls back to its less specific forms, "en-gb" to "en", before the next preferred locale is tried.
+// The default title is used when no locale is translated.
+func localizedTitle(title string, titles map[string]string, locales []string) string {
+	for _, locale := range locales {
+		locale = strings.ToLower(locale)
+
+		for {
+			if translated, ok := titles[locale]; ok {
+				return translated
+			}
+
+			i := strings.LastIndex(locale, "-")
+			if i < 0 {
+				break
+			}
+			locale = locale[:i]
+		}
+	}
+
+	return title
+}
@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bio/pagination"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidTemplate is returned for a maintenance template with a malformed schedule,
+// an archived subtype or a subtype of another type or a performer who is not a worker.
+var ErrInvalidTemplate = errors.New("InvalidTemplate")
+
+// MaintenanceTemplate describes routine work, the scheduler creates an application from it at every run of the schedule.
+type MaintenanceTemplate struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	CreatorID uuid.UUID
+
+	Type        uuid.UUID
+	SubType     uuid.UUID
+	BuildingID  uuid.UUID
+	ApartmentID *uuid.UUID
+	Text        string
+	Priority    ApplicationPriority
+	// PerformerID is assigned to the created applications when the worker is at work.
+	PerformerID *uuid.UUID
+
+	// Schedule is a cron expression in the schedule location.
+	Schedule string
+	Active   bool
+	// NextRunAt is the time of the next application, it is not set for inactive templates.
+	NextRunAt *time.Time
+	LastRunAt *time.Time
+	// LastError is the reason the last run failed, a successful run resets it.
+	LastError *string
+}
+
+// MaintenanceRunError is returned when the run of the claimed template fails.
+// The transaction of the run is unusable then, the failure is recorded with RecordMaintenanceFailure.
+type MaintenanceRunError struct {
+	TemplateID uuid.UUID
+	Err        error
+}
+
+func (e *MaintenanceRunError) Error() string {
+	return "maintenance template " + e.TemplateID.String() + ": " + e.Err.Error()
+}
+
+func (e *MaintenanceRunError) Unwrap() error {
+	return e.Err
+}
+
+// MaintenanceTemplateUpdate holds the template fields to change, nil fields are left as is.
+type MaintenanceTemplateUpdate struct {
+	ID          uuid.UUID
+	Text        *string
+	Priority    *ApplicationPriority
+	PerformerID *uuid.UUID
+	Schedule    *string
+	Active      *bool
+}
+
+type MaintenanceTemplateFilter struct {
+	BuildingID *uuid.UUID
+	Active     *bool
+
+	Pagination pagination.Pagination
+}
+
+// nextRun plans the next run of the template after the given time.
+func (s *Service) nextRun(tmpl *MaintenanceTemplate, after time.Time) error {
+	schedule, err := ParseCronSchedule(tmpl.Schedule)
+	if err != nil {
+		return ErrInvalidTemplate
+	}
+
+	tmpl.NextRunAt = nil
+
+	if !tmpl.Active {
+		return nil
+	}
+
+	next := schedule.Next(after.In(s.getScheduleLocation()))
+	if next.IsZero() {
+		return ErrInvalidTemplate
+	}
+
+	tmpl.NextRunAt = toPoint(next.UTC())
+
+	return nil
+}
+
+func (s *Service) checkTemplate(ctx context.Context, tmpl MaintenanceTemplate) error {
+	subType, err := s.repo.GetApplicationSubType(ctx, tmpl.SubType)
+	if errors.Is(err, ErrNotFound) {
+		return ErrInvalidTemplate
+	}
+	if err != nil {
+		return err
+	}
+
+	if subType.Type != tmpl.Type || subType.ArchivedAt != nil {
+		return ErrInvalidTemplate
+	}
+
+	if _, ok := applicationPriorityRanks[tmpl.Priority]; !ok {
+		return ErrInvalidTemplate
+	}
+
+	if tmpl.PerformerID != nil {
+		performer, err := s.repo.GetUser(ctx, *tmpl.PerformerID)
+		if errors.Is(err, ErrNotFound) {
+			return ErrInvalidTemplate
+		}
+		if err != nil {
+			return err
+		}
+
+		if performer.Role != UserRoleWorker {
+			return ErrInvalidTemplate
+		}
+	}
+
+	return s.checkPremises(ctx, Application{BuildingID: &tmpl.BuildingID, ApartmentID: tmpl.ApartmentID})
+}
+
+func (s *Service) CreateMaintenanceTemplate(ctx context.Context, userID uuid.UUID, tmpl MaintenanceTemplate) (*MaintenanceTemplate, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl.CreatorID = userID
+
+	if tmpl.Priority == "" {
+		tmpl.Priority = ApplPriorityNormal
+	}
+
+	err = s.checkTemplate(ctx, tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.nextRun(&tmpl, tmpl.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateMaintenanceTemplate(ctx, tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetMaintenanceTemplate(ctx, tmpl.ID)
+}
+
+func (s *Service) GetMaintenanceTemplate(ctx context.Context, userID, id uuid.UUID) (*MaintenanceTemplate, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetMaintenanceTemplate(ctx, id)
+}
+
+func (s *Service) ListMaintenanceTemplates(ctx context.Context, userID uuid.UUID, filter MaintenanceTemplateFilter) ([]MaintenanceTemplate, int, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.ListMaintenanceTemplates(ctx, filter)
+}
+
+// UpdateMaintenanceTemplate changes the template, the next run is planned again from now.
+func (s *Service) UpdateMaintenanceTemplate(ctx context.Context, userID uuid.UUID, update MaintenanceTemplateUpdate) (*MaintenanceTemplate, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := s.repo.GetMaintenanceTemplate(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Text != nil {
+		tmpl.Text = *update.Text
+	}
+
+	if update.Priority != nil {
+		tmpl.Priority = *update.Priority
+	}
+
+	if update.PerformerID != nil {
+		tmpl.PerformerID = update.PerformerID
+	}
+
+	if update.Schedule != nil {
+		tmpl.Schedule = *update.Schedule
+	}
+
+	if update.Active != nil {
+		tmpl.Active = *update.Active
+	}
+
+	err = s.checkTemplate(ctx, *tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.nextRun(tmpl, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.UpdateMaintenanceTemplate(ctx, *tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetMaintenanceTemplate(ctx, tmpl.ID)
+}
+
+func (s *Service) DeleteMaintenanceTemplate(ctx context.Context, userID, id uuid.UUID) error {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetMaintenanceTemplate(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.DeleteMaintenanceTemplate(ctx, id, time.Now().UTC())
+}
+
+// RunDueMaintenance creates the application of one template due at the moment and plans the next run.
+// Runs missed while the scheduler was stopped result in a single application.
+// It reports false when no template is due. Unexpected failures of the claimed template
+// are returned as MaintenanceRunError.
+func (s *Service) RunDueMaintenance(ctx context.Context, now time.Time) (bool, error) {
+	tmpl, err := s.repo.ClaimDueMaintenanceTemplate(ctx, now)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	tmpl.LastRunAt = &now
+
+	err = s.checkTemplate(ctx, *tmpl)
+	switch {
+	case errors.Is(err, ErrInvalidTemplate) || errors.Is(err, ErrInvalidPremises):
+		// the premises or the performer are gone, moderators have to fix the template
+		tmpl.Active = false
+		tmpl.NextRunAt = nil
+		tmpl.LastError = toPoint(err.Error())
+		return true, s.repo.UpdateMaintenanceTemplate(ctx, *tmpl)
+	case err != nil:
+		return false, &MaintenanceRunError{TemplateID: tmpl.ID, Err: err}
+	}
+
+	var fieldsErr *FieldsError
+
+	err = s.createMaintenanceApplication(ctx, *tmpl, now)
+	switch {
+	case errors.As(err, &fieldsErr) || errors.Is(err, ErrArchived):
+		// the type is archived or requires custom fields the template does not carry
+		tmpl.Active = false
+		tmpl.NextRunAt = nil
+		tmpl.LastError = toPoint(err.Error())
+		return true, s.repo.UpdateMaintenanceTemplate(ctx, *tmpl)
+	case err != nil:
+		return false, &MaintenanceRunError{TemplateID: tmpl.ID, Err: err}
+	}
+
+	tmpl.LastError = nil
+
+	err = s.nextRun(tmpl, now)
+	if err != nil {
+		return false, &MaintenanceRunError{TemplateID: tmpl.ID, Err: err}
+	}
+
+	return true, s.repo.UpdateMaintenanceTemplate(ctx, *tmpl)
+}
+
+// RecordMaintenanceFailure stores the reason of the failed run on the template and plans the next run,
+// so the scheduler does not retry the template until then. A template without the next run is deactivated.
+func (s *Service) RecordMaintenanceFailure(ctx context.Context, templateID uuid.UUID, now time.Time, cause error) error {
+	tmpl, err := s.repo.GetMaintenanceTemplate(ctx, templateID)
+	if err != nil {
+		return err
+	}
+
+	tmpl.LastRunAt = &now
+	tmpl.LastError = toPoint(cause.Error())
+
+	err = s.nextRun(tmpl, now)
+	if err != nil {
+		tmpl.Active = false
+		tmpl.NextRunAt = nil
+	}
+
+	return s.repo.UpdateMaintenanceTemplate(ctx, *tmpl)
+}
+
+func (s *Service) createMaintenanceApplication(ctx context.Context, tmpl MaintenanceTemplate, now time.Time) error {
+	appl := Application{
+		ID:           uuid.New(),
+		CreatedAt:    now,
+		CreatorID:    tmpl.CreatorID,
+		Status:       ApplStatusCreated,
+		Priority:     tmpl.Priority,
+		Type:         tmpl.Type,
+		SubType:      tmpl.SubType,
+		BuildingID:   &tmpl.BuildingID,
+		ApartmentID:  tmpl.ApartmentID,
+		Text:         tmpl.Text,
+		RecurrenceID: &tmpl.ID,
+	}
+
+	performerID := tmpl.PerformerID
+
+	if performerID != nil {
+		// as on manual assignment only absences matter, the run may be scheduled out of working hours
+		reason, err := s.workerAbsence(ctx, *performerID, now)
+		if err != nil {
+			return err
+		}
+
+		// the absent performer is replaced by the dispatch or by moderators
+		if reason != "" {
+			performerID = nil
+		}
+	}
+
+	appl.PerformerID = performerID
+
+	_, _, err := s.CreateApplication(ctx, appl)
+	if err != nil {
+		return err
+	}
+
+	if performerID == nil {
+		return nil
+	}
+
+	err = s.repo.UpdateApplication(ctx, Application{ID: appl.ID, PerformerID: performerID})
+	if err != nil {
+		return err
+	}
+
+	event := newApplicationEvent(appl.ID, nil, ApplEventFieldPerformerID, nil, uuidToString(performerID))
+	event.Comment = toPoint("performer of the maintenance template")
+
+	return s.repo.CreateApplicationEvents(ctx, []ApplicationEvent{event})
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 page in points and the layout of the text documents.
+const (
+	pdfPageWidth  = 595
+	pdfPageHeight = 842
+	pdfMargin     = 50
+
+	// pdfCharWidth is the advance of every glyph of the monospaced font in thousandths of the font size.
+	pdfCharWidth = 600
+)
+
+// pdfDocument is a minimal writer of text documents. It uses the non-embedded monospaced Courier New font
+// in the Windows-1251 code page, so the documents print Latin and Cyrillic text without font files.
+type pdfDocument struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+func newPDFDocument() *pdfDocument {
+	d := &pdfDocument{}
+	d.newPage()
+	return d
+}
+
+func (d *pdfDocument) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = pdfPageHeight - pdfMargin
+}
+
+// lineWidth is the number of characters fitting the line at the font size.
+func (d *pdfDocument) lineWidth(size float64) int {
+	return int((pdfPageWidth - 2*pdfMargin) * 1000 / (pdfCharWidth * size))
+}
+
+// Text prints the text wrapped by words at the font size.
+func (d *pdfDocument) Text(text string, size float64, bold bool) {
+	for _, paragraph := range strings.Split(text, "\n") {
+		for _, line := range wrapText(paragraph, d.lineWidth(size)) {
+			d.line(line, size, bold)
+		}
+	}
+}
+
+// Gap skips the vertical space of the given height.
+func (d *pdfDocument) Gap(height float64) {
+	d.y -= height
+}
+
+func (d *pdfDocument) line(text string, size float64, bold bool) {
+	leading := size * 1.4
+
+	if d.y-leading < pdfMargin {
+		d.newPage()
+	}
+
+	d.y -= leading
+
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+
+	fmt.Fprintf(d.pages[len(d.pages)-1], "BT /%s %.1f Tf 1 0 0 1 %d %.1f Tm (%s) Tj ET\n",
+		font, size, pdfMargin, d.y, pdfEscape(text))
+}
+
+// wrapText splits the text into lines not longer than width, long words are cut.
+func wrapText(text string, width int) []string {
+	lines := []string{}
+	line := []rune{}
+
+	for _, word := range strings.Fields(text) {
+		runes := []rune(word)
+
+		for len(runes) > width {
+			if len(line) > 0 {
+				lines = append(lines, string(line))
+				line = line[:0]
+			}
+			lines = append(lines, string(runes[:width]))
+			runes = runes[width:]
+		}
+
+		if len(line) > 0 && len(line)+1+len(runes) > width {
+			lines = append(lines, string(line))
+			line = line[:0]
+		}
+
+		if len(line) > 0 {
+			line = append(line, ' ')
+		}
+		line = append(line, runes...)
+	}
+
+	if len(line) > 0 || len(lines) == 0 {
+		lines = append(lines, string(line))
+	}
+
+	return lines
+}
+
+// pdfEscape encodes the text in Windows-1251 as a PDF literal string, unsupported characters become "?".
+func pdfEscape(text string) string {
+	b := &strings.Builder{}
+
+	for _, r := range text {
+		c := byte('?')
+
+		switch {
+		case r >= 0x20 && r < 0x7f:
+			c = byte(r)
+		case r >= 0x0410 && r <= 0x044f:
+			c = byte(r - 0x0410 + 0xc0)
+		case r == 0x0401:
+			c = 0xa8
+		case r == 0x0451:
+			c = 0xb8
+		case r == 0x2116:
+			c = 0xb9
+		}
+
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c >= 0x80:
+			fmt.Fprintf(b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// pdfEncoding maps the Windows-1251 letters to the glyph names of the font.
+func pdfEncoding() string {
+	b := &strings.Builder{}
+
+	b.WriteString("<< /Type /Encoding /BaseEncoding /WinAnsiEncoding /Differences [168 /uni0401 184 /uni0451 /uni2116 192")
+
+	for r := 0x0410; r <= 0x044f; r++ {
+		fmt.Fprintf(b, " /uni%04X", r)
+	}
+
+	b.WriteString("] >>")
+
+	return b.String()
+}
+
+func pdfFont(name string, descriptor int) string {
+	widths := strings.TrimSpace(strings.Repeat(fmt.Sprintf("%d ", pdfCharWidth), 256-32))
+
+	return fmt.Sprintf("<< /Type /Font /Subtype /TrueType /BaseFont /%s /FirstChar 32 /LastChar 255 /Widths [%s] "+
+		"/FontDescriptor %d 0 R /Encoding 3 0 R >>", name, widths, descriptor)
+}
+
+func pdfFontDescriptor(name string, stemV int) string {
+	return fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 33 /FontBBox [-21 -680 638 1021] "+
+		"/ItalicAngle 0 /Ascent 833 /Descent -300 /CapHeight 571 /StemV %d >>", name, stemV)
+}
+
+// Bytes renders the document.
+func (d *pdfDocument) Bytes() []byte {
+	// objects 1 and 2 are the catalog and the page tree, 3-7 are the encoding and the fonts, pages follow
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		pdfEncoding(),
+		pdfFont("CourierNew", 5),
+		pdfFontDescriptor("CourierNew", 80),
+		pdfFont("CourierNew,Bold", 7),
+		pdfFontDescriptor("CourierNew,Bold", 120),
+	}
+
+	kids := []string{}
+
+	for _, page := range d.pages {
+		pageID := len(objects) + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageID))
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 4 0 R /F2 6 0 R >> >> "+
+				"/Contents %d 0 R >>", pdfPageWidth, pdfPageHeight, pageID+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", page.Len(), page.String()),
+		)
+	}
+
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages))
+
+	out := &bytes.Buffer{}
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int, len(objects))
+
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := out.Len()
+
+	fmt.Fprintf(out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+
+	for _, offset := range offsets {
+		fmt.Fprintf(out, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return out.Bytes()
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"time"
+
+	// register decoders for image.Decode
+	_ "image/png"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxPhotoSize = 10 << 20
+	// MaxPhotoPixels bounds the memory of decoding, a small compressed file may declare huge dimensions.
+	MaxPhotoPixels = 40 << 20
+
+	thumbnailMaxSide     = 320
+	thumbnailContentType = "image/jpeg"
+)
+
+var photoContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+}
+
+var (
+	ErrPhotoTooLarge    = errors.New("photo is too large")
+	ErrPhotoContentType = errors.New("unsupported photo content type")
+	// ErrUnknownPhoto is returned when an application refers to a photo that is not uploaded.
+	ErrUnknownPhoto = errors.New("unknown photo")
+)
+
+type PhotoSize string
+
+const (
+	PhotoSizeOriginal  PhotoSize = "original"
+	PhotoSizeThumbnail PhotoSize = "thumbnail"
+)
+
+type Photo struct {
+	ID          uuid.UUID
+	CreatedAt   time.Time
+	CreatorID   uuid.UUID
+	ContentType string
+	Size        int64
+}
+
+// PhotoFile is a stored photo or its thumbnail ready to be sent to a client.
+type PhotoFile struct {
+	Photo       *Photo
+	ContentType string
+	Body        io.ReadCloser
+}
+
+func photoKey(id uuid.UUID, size PhotoSize) string {
+	return "photos/" + id.String() + "/" + string(size)
+}
+
+// UploadPhoto validates the image, stores it with its thumbnail in the blob store
+// and saves the photo metadata. The caller discards the files with DiscardPhotoFiles
+// when the transaction of the metadata is not committed.
+func (s *Service) UploadPhoto(ctx context.Context, creatorID uuid.UUID, file io.Reader) (*Photo, error) {
+	data, err := io.ReadAll(io.LimitReader(file, MaxPhotoSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > MaxPhotoSize {
+		return nil, ErrPhotoTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	if !photoContentTypes[contentType] {
+		return nil, ErrPhotoContentType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrPhotoContentType
+	}
+
+	if int64(config.Width)*int64(config.Height) > MaxPhotoPixels {
+		return nil, ErrPhotoTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrPhotoContentType
+	}
+
+	thumbnail := &bytes.Buffer{}
+
+	err = jpeg.Encode(thumbnail, makeThumbnail(img, thumbnailMaxSide), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	photo := Photo{
+		ID:          uuid.New(),
+		CreatedAt:   time.Now().UTC(),
+		CreatorID:   creatorID,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	}
+
+	err = s.blobs.Put(ctx, photoKey(photo.ID, PhotoSizeOriginal), contentType, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.blobs.Put(ctx, photoKey(photo.ID, PhotoSizeThumbnail), thumbnailContentType, thumbnail)
+	if err != nil {
+		s.deletePhotoBlobs(ctx, photo.ID)
+		return nil, err
+	}
+
+	err = s.repo.CreatePhoto(ctx, photo)
+	if err != nil {
+		s.deletePhotoBlobs(ctx, photo.ID)
+		return nil, err
+	}
+
+	return s.repo.GetPhoto(ctx, photo.ID)
+}
+
+// checkPhotos makes sure that the photos attached to an application are uploaded.
+func (s *Service) checkPhotos(ctx context.Context, ids []uuid.UUID) error {
+	for _, id := range ids {
+		_, err := s.repo.GetPhoto(ctx, id)
+		if errors.Is(err, ErrNotFound) {
+			return ErrUnknownPhoto
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) deletePhotoBlobs(ctx context.Context, id uuid.UUID) {
+	for _, size := range []PhotoSize{PhotoSizeOriginal, PhotoSizeThumbnail} {
+		_ = s.blobs.Delete(ctx, photoKey(id, size))
+	}
+}
+
+// DiscardPhotoFiles removes the stored files of the photo whose metadata is not saved.
+func (s *Service) DiscardPhotoFiles(ctx context.Context, id uuid.UUID) {
+	s.deletePhotoBlobs(ctx, id)
+}
+
+func (s *Service) GetPhoto(ctx context.Context, id uuid.UUID) (*Photo, error) {
+	return s.repo.GetPhoto(ctx, id)
+}
+
+// GetPhotoFile returns the photo metadata and the stored file.
+// The caller must close PhotoFile.Body.
+func (s *Service) GetPhotoFile(ctx context.Context, id uuid.UUID, size PhotoSize) (*PhotoFile, error) {
+	photo, err := s.repo.GetPhoto(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := photo.ContentType
+	if size == PhotoSizeThumbnail {
+		contentType = thumbnailContentType
+	}
+
+	body, err := s.blobs.Get(ctx, photoKey(id, size))
+	if err != nil {
+		return nil, err
+	}
+
+	return &PhotoFile{
+		Photo:       photo,
+		ContentType: contentType,
+		Body:        body,
+	}, nil
+}
+
+// makeThumbnail scales the image down so that its longest side is not greater than maxSide.
+func makeThumbnail(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	if width <= maxSide && height <= maxSide {
+		return img
+	}
+
+	thumbWidth, thumbHeight := maxSide, height*maxSide/width
+	if height > width {
+		thumbWidth, thumbHeight = width*maxSide/height, maxSide
+	}
+
+	if thumbWidth == 0 {
+		thumbWidth = 1
+	}
+
+	if thumbHeight == 0 {
+		thumbHeight = 1
+	}
+
+	thumb := image.NewRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+
+	for y := 0; y < thumbHeight; y++ {
+		for x := 0; x < thumbWidth; x++ {
+			thumb.Set(x, y, img.At(bounds.Min.X+x*width/thumbWidth, bounds.Min.Y+y*height/thumbHeight))
+		}
+	}
+
+	return thumb
+}
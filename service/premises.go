@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bio/pagination"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidPremises is returned when the building, entrance and apartment do not match each other.
+var ErrInvalidPremises = errors.New("InvalidPremises")
+
+type Building struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	Address   string
+
+	// Location of the building, it is used for proximity queries over applications.
+	Location *GeoPoint
+}
+
+// BuildingUpdate holds the building fields to change, nil fields are left as is.
+type BuildingUpdate struct {
+	ID       uuid.UUID
+	Address  *string
+	Location *GeoPoint
+}
+
+type BuildingFilter struct {
+	Pagination pagination.Pagination
+}
+
+type Entrance struct {
+	ID         uuid.UUID
+	BuildingID uuid.UUID
+	Number     string
+}
+
+// EntranceUpdate holds the entrance fields to change, nil fields are left as is.
+type EntranceUpdate struct {
+	ID     uuid.UUID
+	Number *string
+}
+
+type EntranceFilter struct {
+	BuildingID uuid.UUID
+
+	Pagination pagination.Pagination
+}
+
+type Apartment struct {
+	ID         uuid.UUID
+	BuildingID uuid.UUID
+	EntranceID *uuid.UUID
+	Number     string
+	Floor      *int
+}
+
+// ApartmentUpdate holds the apartment fields to change, nil fields are left as is.
+type ApartmentUpdate struct {
+	ID         uuid.UUID
+	EntranceID *uuid.UUID
+	Number     *string
+	Floor      *int
+}
+
+type ApartmentFilter struct {
+	BuildingID uuid.UUID
+	EntranceID *uuid.UUID
+
+	Pagination pagination.Pagination
+}
+
+// checkModerator makes sure that the user manages the reference data.
+func (s *Service) checkModerator(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.Role != UserRoleModerator {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func (s *Service) CreateBuilding(ctx context.Context, userID uuid.UUID, building Building) (*Building, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateBuilding(ctx, building)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetBuilding(ctx, building.ID)
+}
+
+func (s *Service) GetBuilding(ctx context.Context, id uuid.UUID) (*Building, error) {
+	return s.repo.GetBuilding(ctx, id)
+}
+
+func (s *Service) ListBuildings(ctx context.Context, filter BuildingFilter) ([]Building, int, error) {
+	return s.repo.ListBuildings(ctx, filter)
+}
+
+func (s *Service) UpdateBuilding(ctx context.Context, userID uuid.UUID, update BuildingUpdate) (*Building, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	building, err := s.repo.GetBuilding(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Address != nil {
+		building.Address = *update.Address
+	}
+
+	if update.Location != nil {
+		building.Location = update.Location
+	}
+
+	err = s.repo.UpdateBuilding(ctx, *building)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetBuilding(ctx, building.ID)
+}
+
+func (s *Service) DeleteBuilding(ctx context.Context, userID, id uuid.UUID) error {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetBuilding(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.DeleteBuilding(ctx, id, time.Now().UTC())
+}
+
+func (s *Service) CreateEntrance(ctx context.Context, userID uuid.UUID, entrance Entrance) (*Entrance, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.repo.GetBuilding(ctx, entrance.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateEntrance(ctx, entrance)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetEntrance(ctx, entrance.ID)
+}
+
+func (s *Service) ListEntrances(ctx context.Context, filter EntranceFilter) ([]Entrance, int, error) {
+	_, err := s.repo.GetBuilding(ctx, filter.BuildingID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.ListEntrances(ctx, filter)
+}
+
+func (s *Service) UpdateEntrance(ctx context.Context, userID uuid.UUID, update EntranceUpdate) (*Entrance, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	entrance, err := s.repo.GetEntrance(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Number != nil {
+		entrance.Number = *update.Number
+	}
+
+	err = s.repo.UpdateEntrance(ctx, *entrance)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetEntrance(ctx, entrance.ID)
+}
+
+func (s *Service) DeleteEntrance(ctx context.Context, userID, id uuid.UUID) error {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetEntrance(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.DeleteEntrance(ctx, id, time.Now().UTC())
+}
+
+func (s *Service) CreateApartment(ctx context.Context, userID uuid.UUID, apartment Apartment) (*Apartment, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.repo.GetBuilding(ctx, apartment.BuildingID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkEntrance(ctx, apartment.BuildingID, apartment.EntranceID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateApartment(ctx, apartment)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApartment(ctx, apartment.ID)
+}
+
+func (s *Service) GetApartment(ctx context.Context, id uuid.UUID) (*Apartment, error) {
+	return s.repo.GetApartment(ctx, id)
+}
+
+func (s *Service) ListApartments(ctx context.Context, filter ApartmentFilter) ([]Apartment, int, error) {
+	_, err := s.repo.GetBuilding(ctx, filter.BuildingID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.ListApartments(ctx, filter)
+}
+
+func (s *Service) UpdateApartment(ctx context.Context, userID uuid.UUID, update ApartmentUpdate) (*Apartment, error) {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	apartment, err := s.repo.GetApartment(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.EntranceID != nil {
+		err = s.checkEntrance(ctx, apartment.BuildingID, update.EntranceID)
+		if err != nil {
+			return nil, err
+		}
+		apartment.EntranceID = update.EntranceID
+	}
+
+	if update.Number != nil {
+		apartment.Number = *update.Number
+	}
+
+	if update.Floor != nil {
+		apartment.Floor = update.Floor
+	}
+
+	err = s.repo.UpdateApartment(ctx, *apartment)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApartment(ctx, apartment.ID)
+}
+
+func (s *Service) DeleteApartment(ctx context.Context, userID, id uuid.UUID) error {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.GetApartment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.DeleteApartment(ctx, id, time.Now().UTC())
+}
+
+// checkEntrance makes sure that the entrance, if set, belongs to the building.
+func (s *Service) checkEntrance(ctx context.Context, buildingID uuid.UUID, entranceID *uuid.UUID) error {
+	if entranceID == nil {
+		return nil
+	}
+
+	entrance, err := s.repo.GetEntrance(ctx, *entranceID)
+	if errors.Is(err, ErrNotFound) {
+		return ErrInvalidPremises
+	}
+	if err != nil {
+		return err
+	}
+
+	if entrance.BuildingID != buildingID {
+		return ErrInvalidPremises
+	}
+
+	return nil
+}
+
+// checkPremises makes sure that the application refers to an existing building
+// and, if the apartment is set, the apartment is in that building.
+func (s *Service) checkPremises(ctx context.Context, appl Application) error {
+	if appl.BuildingID == nil {
+		return ErrInvalidPremises
+	}
+
+	_, err := s.repo.GetBuilding(ctx, *appl.BuildingID)
+	if errors.Is(err, ErrNotFound) {
+		return ErrInvalidPremises
+	}
+	if err != nil {
+		return err
+	}
+
+	if appl.ApartmentID == nil {
+		return nil
+	}
+
+	apartment, err := s.repo.GetApartment(ctx, *appl.ApartmentID)
+	if errors.Is(err, ErrNotFound) {
+		return ErrInvalidPremises
+	}
+	if err != nil {
+		return err
+	}
+
+	if apartment.BuildingID != *appl.BuildingID {
+		return ErrInvalidPremises
+	}
+
+	return nil
+}
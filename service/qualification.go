@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidQualification is returned for qualifications of a user who is not a worker,
+// with an unknown type, a subtype of another type or the same type and subtype twice.
+var ErrInvalidQualification = errors.New("InvalidQualification")
+
+// WorkerQualification lets the dispatcher assign applications of the type to the worker.
+// A nil subtype qualifies the worker for every subtype of the type.
+type WorkerQualification struct {
+	TypeID    uuid.UUID
+	SubTypeID *uuid.UUID
+}
+
+// checkQualifiedWorker makes sure that the user is a moderator and the worker exists and is a worker.
+func (s *Service) checkQualifiedWorker(ctx context.Context, userID, workerID uuid.UUID) error {
+	err := s.checkModerator(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	worker, err := s.repo.GetUser(ctx, workerID)
+	if err != nil {
+		return err
+	}
+
+	if worker.Role != UserRoleWorker {
+		return ErrInvalidQualification
+	}
+
+	return nil
+}
+
+// GetWorkerQualifications returns the types and subtypes dispatched to the worker. Only for moderators.
+func (s *Service) GetWorkerQualifications(ctx context.Context, userID, workerID uuid.UUID) ([]WorkerQualification, error) {
+	err := s.checkQualifiedWorker(ctx, userID, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListWorkerQualifications(ctx, workerID)
+}
+
+// SetWorkerQualifications replaces the qualifications of the worker. Only for moderators.
+// An empty list excludes the worker from the automatic dispatch.
+func (s *Service) SetWorkerQualifications(ctx context.Context, userID, workerID uuid.UUID, qualifications []WorkerQualification) ([]WorkerQualification, error) {
+	err := s.checkQualifiedWorker(ctx, userID, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// a nil subtype is kept as the zero id, the same as in the unique index
+	type key struct{ typeID, subTypeID uuid.UUID }
+
+	seen := make(map[key]bool, len(qualifications))
+
+	for _, q := range qualifications {
+		err = s.checkQualification(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+
+		k := key{typeID: q.TypeID}
+		if q.SubTypeID != nil {
+			k.subTypeID = *q.SubTypeID
+		}
+
+		if seen[k] {
+			return nil, ErrInvalidQualification
+		}
+		seen[k] = true
+	}
+
+	err = s.repo.ReplaceWorkerQualifications(ctx, workerID, qualifications)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListWorkerQualifications(ctx, workerID)
+}
+
+// checkQualification makes sure that the type exists and the subtype belongs to it.
+func (s *Service) checkQualification(ctx context.Context, q WorkerQualification) error {
+	_, err := s.repo.GetApplicationType(ctx, q.TypeID)
+	if errors.Is(err, ErrNotFound) {
+		return ErrInvalidQualification
+	}
+	if err != nil {
+		return err
+	}
+
+	if q.SubTypeID == nil {
+		return nil
+	}
+
+	subType, err := s.repo.GetApplicationSubType(ctx, *q.SubTypeID)
+	if errors.Is(err, ErrNotFound) {
+		return ErrInvalidQualification
+	}
+	if err != nil {
+		return err
+	}
+
+	if subType.Type != q.TypeID {
+		return ErrInvalidQualification
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+var (
+	ErrInvalidRating    = errors.New("rating must be from 1 to 5")
+	ErrRatingNotAllowed = errors.New("only done applications can be rated")
+)
+
+// RatingStat is the aggregated rating of applications grouped by a worker or an application type.
+type RatingStat struct {
+	ID      uuid.UUID
+	Count   int
+	Average float64
+}
+
+type RatingReport struct {
+	ByWorker []RatingStat
+	ByType   []RatingStat
+}
+
+// RateApplication saves the feedback of the creator on the completed application.
+func (s *Service) RateApplication(ctx context.Context, userID, applicationID uuid.UUID, rating int, review string) (*Application, error) {
+	if rating < MinRating || rating > MaxRating {
+		return nil, ErrInvalidRating
+	}
+
+	current, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.CreatorID != userID {
+		return nil, ErrForbidden
+	}
+
+	if current.Status != ApplStatusDone {
+		return nil, ErrRatingNotAllowed
+	}
+
+	err = s.repo.RateApplication(ctx, applicationID, rating, review, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplication(ctx, applicationID)
+}
+
+// GetRatingReport returns average ratings per worker and per application type. Only for moderators.
+func (s *Service) GetRatingReport(ctx context.Context, userID uuid.UUID) (*RatingReport, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role != UserRoleModerator {
+		return nil, ErrForbidden
+	}
+
+	byWorker, err := s.repo.ListWorkerRatings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byType, err := s.repo.ListApplicationTypeRatings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RatingReport{
+		ByWorker: byWorker,
+		ByType:   byType,
+	}, nil
+}
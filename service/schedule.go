@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"bio/pagination"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidSchedule is returned for overlapping or malformed working hours and absences.
+var ErrInvalidSchedule = errors.New("InvalidSchedule")
+
+const minutesInDay = 24 * 60
+
+// WorkingHours is the interval the worker is at work on the weekday.
+// Minutes are counted from the midnight in the schedule location.
+type WorkingHours struct {
+	Weekday     time.Weekday
+	StartMinute int
+	EndMinute   int
+}
+
+func (h WorkingHours) isValid() bool {
+	return h.Weekday >= time.Sunday && h.Weekday <= time.Saturday &&
+		h.StartMinute >= 0 && h.EndMinute <= minutesInDay && h.EndMinute > h.StartMinute
+}
+
+type AbsenceReason string
+
+const (
+	AbsenceReasonDayOff    AbsenceReason = "day_off"
+	AbsenceReasonVacation  AbsenceReason = "vacation"
+	AbsenceReasonSickLeave AbsenceReason = "sick_leave"
+)
+
+// WorkerAbsence is the date-based exception of the weekly hours,
+// the worker is not at work from StartsOn till EndsOn inclusive.
+type WorkerAbsence struct {
+	ID        uuid.UUID
+	WorkerID  uuid.UUID
+	CreatedAt time.Time
+	StartsOn  time.Time
+	EndsOn    time.Time
+	Reason    AbsenceReason
+	Comment   *string
+}
+
+type WorkerAbsenceFilter struct {
+	WorkerID uuid.UUID
+	From     *time.Time
+	To       *time.Time
+
+	Pagination pagination.Pagination
+}
+
+// WithScheduleLocation returns the service that reads working hours and absences in the location.
+func (s *Service) WithScheduleLocation(loc *time.Location) *Service {
+	srv := &Service{}
+	*srv = *s
+	srv.scheduleLocation = loc
+	return srv
+}
+
+func (s *Service) getScheduleLocation() *time.Location {
+	if s.scheduleLocation == nil {
+		return time.UTC
+	}
+
+	return s.scheduleLocation
+}
+
+// checkScheduleAccess makes sure that the worker exists and the user may manage the worker schedule.
+// Moderators manage schedules of all workers, a worker manages its own one.
+func (s *Service) checkScheduleAccess(ctx context.Context, userID, workerID uuid.UUID) error {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	worker, err := s.repo.GetUser(ctx, workerID)
+	if err != nil {
+		return err
+	}
+
+	if user.ID != worker.ID && user.Role != UserRoleModerator {
+		return ErrForbidden
+	}
+
+	if worker.Role != UserRoleWorker {
+		return ErrInvalidSchedule
+	}
+
+	return nil
+}
+
+func (s *Service) GetWorkingHours(ctx context.Context, userID, workerID uuid.UUID) ([]WorkingHours, error) {
+	err := s.checkScheduleAccess(ctx, userID, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListWorkingHours(ctx, workerID)
+}
+
+// SetWorkingHours replaces the weekly hours of the worker, intervals of a day may not overlap.
+// An empty list means that the worker is available at any time.
+func (s *Service) SetWorkingHours(ctx context.Context, userID, workerID uuid.UUID, hours []WorkingHours) ([]WorkingHours, error) {
+	err := s.checkScheduleAccess(ctx, userID, workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := append([]WorkingHours{}, hours...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Weekday != sorted[j].Weekday {
+			return sorted[i].Weekday < sorted[j].Weekday
+		}
+		return sorted[i].StartMinute < sorted[j].StartMinute
+	})
+
+	for i, h := range sorted {
+		if !h.isValid() {
+			return nil, ErrInvalidSchedule
+		}
+
+		if i > 0 && sorted[i-1].Weekday == h.Weekday && sorted[i-1].EndMinute > h.StartMinute {
+			return nil, ErrInvalidSchedule
+		}
+	}
+
+	err = s.repo.ReplaceWorkingHours(ctx, workerID, sorted)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListWorkingHours(ctx, workerID)
+}
+
+func (s *Service) CreateWorkerAbsence(ctx context.Context, userID uuid.UUID, absence WorkerAbsence) (*WorkerAbsence, error) {
+	err := s.checkScheduleAccess(ctx, userID, absence.WorkerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if absence.EndsOn.Before(absence.StartsOn) {
+		return nil, ErrInvalidSchedule
+	}
+
+	switch absence.Reason {
+	case AbsenceReasonDayOff, AbsenceReasonVacation, AbsenceReasonSickLeave:
+	default:
+		return nil, ErrInvalidSchedule
+	}
+
+	err = s.repo.CreateWorkerAbsence(ctx, absence)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetWorkerAbsence(ctx, absence.ID)
+}
+
+func (s *Service) ListWorkerAbsences(ctx context.Context, userID uuid.UUID, filter WorkerAbsenceFilter) ([]WorkerAbsence, int, error) {
+	err := s.checkScheduleAccess(ctx, userID, filter.WorkerID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.ListWorkerAbsences(ctx, filter)
+}
+
+func (s *Service) DeleteWorkerAbsence(ctx context.Context, userID, absenceID uuid.UUID) error {
+	absence, err := s.repo.GetWorkerAbsence(ctx, absenceID)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkScheduleAccess(ctx, userID, absence.WorkerID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.DeleteWorkerAbsence(ctx, absence.ID, time.Now().UTC())
+}
+
+// workerAbsence returns why the worker is absent on the day of the moment, empty reason means the worker is not absent.
+func (s *Service) workerAbsence(ctx context.Context, workerID uuid.UUID, at time.Time) (string, error) {
+	absence, err := s.repo.FindWorkerAbsence(ctx, workerID, s.scheduleDay(at))
+	switch {
+	case err == nil:
+		return fmt.Sprintf("performer is absent (%s) till %s", absence.Reason, absence.EndsOn.Format("2006-01-02")), nil
+	case errors.Is(err, ErrNotFound):
+		return "", nil
+	}
+
+	return "", err
+}
+
+// scheduleDay returns the date of the moment in the schedule location, absences are stored as such dates.
+func (s *Service) scheduleDay(at time.Time) time.Time {
+	local := at.In(s.getScheduleLocation())
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// checkWorkerAvailability makes sure that the worker is not absent on the day of the assignment.
+// Weekly hours are not checked: the application is assigned for days ahead and visits are
+// planned with slots, which have to fit the working hours.
+func (s *Service) checkWorkerAvailability(ctx context.Context, workerID uuid.UUID, status ApplicationStatus) error {
+	reason, err := s.workerAbsence(ctx, workerID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if reason != "" {
+		return &AssignmentError{Status: status, Reason: reason}
+	}
+
+	return nil
+}
+
+// checkSlotSchedule makes sure that the slot falls within one interval of the weekly hours of the worker
+// and on none of its absences. Workers without weekly hours may publish slots at any time they are not absent.
+func (s *Service) checkSlotSchedule(ctx context.Context, slot VisitSlot) error {
+	// the slot ending at midnight does not touch the next day
+	lastMoment := slot.EndsAt.Add(-time.Nanosecond)
+
+	from, to := s.scheduleDay(slot.StartsAt), s.scheduleDay(lastMoment)
+
+	_, absences, err := s.repo.ListWorkerAbsences(ctx, WorkerAbsenceFilter{
+		WorkerID:   slot.WorkerID,
+		From:       &from,
+		To:         &to,
+		Pagination: pagination.Pagination{Limit: 1},
+	})
+	if err != nil {
+		return err
+	}
+
+	if absences > 0 {
+		return ErrSlotOffSchedule
+	}
+
+	hours, err := s.repo.ListWorkingHours(ctx, slot.WorkerID)
+	if err != nil {
+		return err
+	}
+
+	if len(hours) == 0 {
+		return nil
+	}
+
+	if !from.Equal(to) {
+		return ErrSlotOffSchedule
+	}
+
+	starts, ends := slot.StartsAt.In(s.getScheduleLocation()), lastMoment.In(s.getScheduleLocation())
+	startMinute := starts.Hour()*60 + starts.Minute()
+	endMinute := ends.Hour()*60 + ends.Minute() + 1
+
+	for _, h := range hours {
+		if h.Weekday == starts.Weekday() && h.StartMinute <= startMinute && endMinute <= h.EndMinute {
+			return nil
+		}
+	}
+
+	return ErrSlotOffSchedule
+}
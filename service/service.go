@@ -3,13 +3,22 @@ package service
 import (
 	"context"
 	"errors"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Service struct {
-	repo Repo
+	repo     Repo
+	blobs    BlobStore
+	dispatch DispatchStrategy
+
+	reopenWindow    time.Duration
+	duplicateWindow time.Duration
+
+	// scheduleLocation is the time zone of the working hours and absences and of the dates in documents.
+	scheduleLocation *time.Location
 }
 
 type Repo interface {
@@ -17,9 +26,104 @@ type Repo interface {
 	GetApplication(context.Context, uuid.UUID) (*Application, error)
 	ListApplication(context.Context, ApplicationFilter) ([]*Application, int, error)
 	UpdateApplication(context.Context, Application) error
+	ClearApplicationPerformer(ctx context.Context, id uuid.UUID) error
+	ListDispatchCandidates(ctx context.Context, applType, applSubType uuid.UUID) ([]DispatchCandidate, error)
+	ListWorkerQualifications(ctx context.Context, workerID uuid.UUID) ([]WorkerQualification, error)
+	ReplaceWorkerQualifications(ctx context.Context, workerID uuid.UUID, qualifications []WorkerQualification) error
+	RateApplication(ctx context.Context, id uuid.UUID, rating int, review string, ratedAt time.Time) error
+	ListWorkerRatings(ctx context.Context) ([]RatingStat, error)
+	ListApplicationTypeRatings(ctx context.Context) ([]RatingStat, error)
+	ListDuplicateCandidates(ctx context.Context, filter DuplicateFilter) ([]*Application, error)
+
+	CreateApplicationType(ctx context.Context, applType ApplicationType) error
+	GetApplicationType(ctx context.Context, id uuid.UUID) (*ApplicationType, error)
+	ListApplicationTypes(ctx context.Context, filters ApplicationTypeFilter) ([]ApplicationType, int, error)
+	UpdateApplicationType(ctx context.Context, applType ApplicationType) error
+	SetApplicationTypePositions(ctx context.Context, ids []uuid.UUID) error
+	CreateApplicationSubType(ctx context.Context, subType ApplicationSubType) error
+	GetApplicationSubType(ctx context.Context, id uuid.UUID) (*ApplicationSubType, error)
+	ListApplicationSubTypes(ctx context.Context, filters ApplicationSubTypeFilter) ([]ApplicationSubType, int, error)
+	UpdateApplicationSubType(ctx context.Context, subType ApplicationSubType) error
+	SetApplicationSubTypePositions(ctx context.Context, ids []uuid.UUID) error
+
+	CreateApplicationEvents(ctx context.Context, events []ApplicationEvent) error
+	ListApplicationEvents(ctx context.Context, filters ApplicationEventFilter) ([]ApplicationEvent, int, error)
+
+	CreateApplicationComment(ctx context.Context, comment ApplicationComment) error
+	GetApplicationComment(ctx context.Context, id uuid.UUID) (*ApplicationComment, error)
+	ListApplicationComments(ctx context.Context, filters ApplicationCommentFilter) ([]ApplicationComment, int, error)
+	UpdateApplicationComment(ctx context.Context, comment ApplicationComment) error
+	DeleteApplicationComment(ctx context.Context, id uuid.UUID, currentTime time.Time) error
+
+	CreateApplicationLineItem(ctx context.Context, item ApplicationLineItem) error
+	GetApplicationLineItem(ctx context.Context, id uuid.UUID) (*ApplicationLineItem, error)
+	ListApplicationLineItems(ctx context.Context, filters ApplicationLineItemFilter) ([]ApplicationLineItem, int, error)
+	UpdateApplicationLineItem(ctx context.Context, item ApplicationLineItem) error
+	DeleteApplicationLineItem(ctx context.Context, id uuid.UUID, currentTime time.Time) error
+	ListApplicationTypeCosts(ctx context.Context) ([]CostStat, error)
+
+	ListSubTypeChecklist(ctx context.Context, subTypeID uuid.UUID) ([]ChecklistItem, error)
+	ReplaceSubTypeChecklist(ctx context.Context, subTypeID uuid.UUID, items []ChecklistItem) error
+	CreateApplicationChecklist(ctx context.Context, items []ApplicationChecklistItem) error
+	GetApplicationChecklistItem(ctx context.Context, id uuid.UUID) (*ApplicationChecklistItem, error)
+	ListApplicationChecklist(ctx context.Context, applicationID uuid.UUID) ([]ApplicationChecklistItem, error)
+	UpdateApplicationChecklistItem(ctx context.Context, item ApplicationChecklistItem) error
+
+	CreateBuilding(ctx context.Context, building Building) error
+	GetBuilding(ctx context.Context, id uuid.UUID) (*Building, error)
+	ListBuildings(ctx context.Context, filters BuildingFilter) ([]Building, int, error)
+	UpdateBuilding(ctx context.Context, building Building) error
+	DeleteBuilding(ctx context.Context, id uuid.UUID, currentTime time.Time) error
+
+	CreateEntrance(ctx context.Context, entrance Entrance) error
+	GetEntrance(ctx context.Context, id uuid.UUID) (*Entrance, error)
+	ListEntrances(ctx context.Context, filters EntranceFilter) ([]Entrance, int, error)
+	UpdateEntrance(ctx context.Context, entrance Entrance) error
+	DeleteEntrance(ctx context.Context, id uuid.UUID, currentTime time.Time) error
 
-	ListApplicationTypes(ctx context.Context, filters ApplicationFilter) ([]ApplicationType, int, error)
-	ListApplicationSubTypes(ctx context.Context, filters ApplicationFilter) ([]ApplicationSubType, int, error)
+	CreateApartment(ctx context.Context, apartment Apartment) error
+	GetApartment(ctx context.Context, id uuid.UUID) (*Apartment, error)
+	ListApartments(ctx context.Context, filters ApartmentFilter) ([]Apartment, int, error)
+	UpdateApartment(ctx context.Context, apartment Apartment) error
+	DeleteApartment(ctx context.Context, id uuid.UUID, currentTime time.Time) error
+
+	CreateVisitSlot(ctx context.Context, slot VisitSlot) error
+	GetVisitSlot(ctx context.Context, id uuid.UUID) (*VisitSlot, error)
+	ListVisitSlots(ctx context.Context, filters VisitSlotFilter) ([]VisitSlot, int, error)
+	DeleteVisitSlot(ctx context.Context, id uuid.UUID) error
+	// HasOverlappingVisitSlot checks other slots of the worker, only booked ones if bookedOnly is set.
+	HasOverlappingVisitSlot(ctx context.Context, slot VisitSlot, bookedOnly bool) (bool, error)
+	BookVisitSlot(ctx context.Context, id, applicationID uuid.UUID, bookedAt time.Time) error
+	ReleaseVisitSlots(ctx context.Context, applicationID uuid.UUID) error
+	// ReleaseVisitSlotsFrom frees the slots of the application starting at the time or later.
+	ReleaseVisitSlotsFrom(ctx context.Context, applicationID uuid.UUID, from time.Time) error
+	// LockWorker serializes changes of the worker slots until the end of the transaction.
+	LockWorker(ctx context.Context, workerID uuid.UUID) error
+
+	ListWorkingHours(ctx context.Context, workerID uuid.UUID) ([]WorkingHours, error)
+	ReplaceWorkingHours(ctx context.Context, workerID uuid.UUID, hours []WorkingHours) error
+	CreateWorkerAbsence(ctx context.Context, absence WorkerAbsence) error
+	GetWorkerAbsence(ctx context.Context, id uuid.UUID) (*WorkerAbsence, error)
+	ListWorkerAbsences(ctx context.Context, filters WorkerAbsenceFilter) ([]WorkerAbsence, int, error)
+	DeleteWorkerAbsence(ctx context.Context, id uuid.UUID, currentTime time.Time) error
+	// FindWorkerAbsence returns an absence of the worker covering the day or ErrNotFound.
+	FindWorkerAbsence(ctx context.Context, workerID uuid.UUID, day time.Time) (*WorkerAbsence, error)
+
+	CreateMaintenanceTemplate(ctx context.Context, tmpl MaintenanceTemplate) error
+	GetMaintenanceTemplate(ctx context.Context, id uuid.UUID) (*MaintenanceTemplate, error)
+	ListMaintenanceTemplates(ctx context.Context, filters MaintenanceTemplateFilter) ([]MaintenanceTemplate, int, error)
+	UpdateMaintenanceTemplate(ctx context.Context, tmpl MaintenanceTemplate) error
+	DeleteMaintenanceTemplate(ctx context.Context, id uuid.UUID, currentTime time.Time) error
+	// ClaimDueMaintenanceTemplate locks an active template due at the moment, templates locked
+	// by other schedulers are skipped. ErrNotFound means that nothing is due.
+	ClaimDueMaintenanceTemplate(ctx context.Context, now time.Time) (*MaintenanceTemplate, error)
+
+	// SaveActSnapshot replaces the snapshot of the completion act of the application.
+	SaveActSnapshot(ctx context.Context, snapshot ActSnapshot) error
+	GetActSnapshot(ctx context.Context, applicationID uuid.UUID) (*ActSnapshot, error)
+
+	CreatePhoto(ctx context.Context, photo Photo) error
+	GetPhoto(ctx context.Context, id uuid.UUID) (*Photo, error)
 
 	CreateUser(ctx context.Context, user User) error
 	GetUser(ctx context.Context, id uuid.UUID) (*User, error)
@@ -27,6 +131,13 @@ type Repo interface {
 	DeleteUser(ctx context.Context, id uuid.UUID, currentTime time.Time) error
 }
 
+// BlobStore keeps binary files such as photos.
+type BlobStore interface {
+	Put(ctx context.Context, key string, contentType string, data io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
 func (s *Service) SetTransaction(repo Repo) *Service {
 	srv := &Service{}
 	*srv = *s
@@ -34,10 +145,14 @@ func (s *Service) SetTransaction(repo Repo) *Service {
 	return srv
 }
 
-var ErrNotFound = errors.New("NotFound")
+var (
+	ErrNotFound  = errors.New("NotFound")
+	ErrForbidden = errors.New("Forbidden")
+)
 
-func NewService(repo Repo) *Service {
+func NewService(repo Repo, blobs BlobStore) *Service {
 	return &Service{
-		repo: repo,
+		repo:  repo,
+		blobs: blobs,
 	}
 }
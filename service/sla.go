@@ -0,0 +1,39 @@
+package service
+
+import (
+	"time"
+)
+
+// Deadlines returns the response and resolution deadlines for an application created at the given time.
+func (st ApplicationSubType) Deadlines(createdAt time.Time) (responseDueAt, dueAt *time.Time) {
+	if st.ResponseMinutes != nil {
+		responseDueAt = toPoint(createdAt.Add(time.Duration(*st.ResponseMinutes) * time.Minute))
+	}
+
+	if st.ResolutionMinutes != nil {
+		dueAt = toPoint(createdAt.Add(time.Duration(*st.ResolutionMinutes) * time.Minute))
+	}
+
+	return responseDueAt, dueAt
+}
+
+// IsOpen reports whether the work on the application is not finished.
+func (s ApplicationStatus) IsOpen() bool {
+	return s == ApplStatusCreated || s == ApplStatusInProgress || s == ApplStatusReopened
+}
+
+// IsOverdue reports whether the open application missed the response or the resolution deadline.
+// The response deadline matters only until the application is taken into work.
+func (a Application) IsOverdue(now time.Time) bool {
+	if !a.Status.IsOpen() {
+		return false
+	}
+
+	waitsResponse := a.Status == ApplStatusCreated || a.Status == ApplStatusReopened
+
+	if waitsResponse && a.ResponseDueAt != nil && a.ResponseDueAt.Before(now) {
+		return true
+	}
+
+	return a.DueAt != nil && a.DueAt.Before(now)
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"bio/pagination"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidSlot is returned for a slot that ends before it starts or is already in the past.
+	ErrInvalidSlot = errors.New("InvalidSlot")
+	// ErrSlotConflict is returned when the slot overlaps other slots of the worker or is already booked.
+	ErrSlotConflict = errors.New("SlotConflict")
+	// ErrSlotOffSchedule is returned for a slot outside the working hours of the worker or on its absence.
+	ErrSlotOffSchedule = errors.New("SlotOffSchedule")
+)
+
+// VisitSlot is the time a worker is available to visit the premises.
+// The slot is booked when it is linked to an application.
+type VisitSlot struct {
+	ID        uuid.UUID
+	WorkerID  uuid.UUID
+	CreatedAt time.Time
+	StartsAt  time.Time
+	EndsAt    time.Time
+
+	ApplicationID *uuid.UUID
+	BookedAt      *time.Time
+}
+
+func (s VisitSlot) IsBooked() bool {
+	return s.ApplicationID != nil
+}
+
+type VisitSlotFilter struct {
+	WorkerID uuid.UUID
+	From     *time.Time
+	To       *time.Time
+	// Available selects free slots if true and booked slots if false.
+	Available *bool
+
+	Pagination pagination.Pagination
+}
+
+// CreateVisitSlot publishes the time the worker is available, slots of a worker may not overlap.
+func (s *Service) CreateVisitSlot(ctx context.Context, userID uuid.UUID, slot VisitSlot) (*VisitSlot, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role != UserRoleWorker {
+		return nil, ErrForbidden
+	}
+
+	if !slot.EndsAt.After(slot.StartsAt) || slot.StartsAt.Before(slot.CreatedAt) {
+		return nil, ErrInvalidSlot
+	}
+
+	slot.WorkerID = user.ID
+
+	err = s.checkSlotSchedule(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.LockWorker(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	overlaps, err := s.repo.HasOverlappingVisitSlot(ctx, slot, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if overlaps {
+		return nil, ErrSlotConflict
+	}
+
+	err = s.repo.CreateVisitSlot(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetVisitSlot(ctx, slot.ID)
+}
+
+func (s *Service) ListVisitSlots(ctx context.Context, filter VisitSlotFilter) ([]VisitSlot, int, error) {
+	_, err := s.repo.GetUser(ctx, filter.WorkerID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.ListVisitSlots(ctx, filter)
+}
+
+// DeleteVisitSlot withdraws a free slot. The worker and moderators may do it.
+func (s *Service) DeleteVisitSlot(ctx context.Context, userID, slotID uuid.UUID) error {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	slot, err := s.repo.GetVisitSlot(ctx, slotID)
+	if err != nil {
+		return err
+	}
+
+	if slot.WorkerID != user.ID && user.Role != UserRoleModerator {
+		return ErrForbidden
+	}
+
+	if slot.IsBooked() {
+		return ErrSlotConflict
+	}
+
+	return s.repo.DeleteVisitSlot(ctx, slot.ID)
+}
+
+// BookVisitSlot lets the creator of the application book a free slot of its performer.
+// The previous booking of the application, if any, is released.
+func (s *Service) BookVisitSlot(ctx context.Context, userID, applicationID, slotID uuid.UUID) (*Application, error) {
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.GetApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.CreatorID != user.ID {
+		return nil, ErrForbidden
+	}
+
+	if !current.Status.IsOpen() || current.PerformerID == nil {
+		return nil, &AssignmentError{Status: current.Status, Reason: "visit can be booked only for an open application with a performer"}
+	}
+
+	slot, err := s.repo.GetVisitSlot(ctx, slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	if slot.WorkerID != *current.PerformerID {
+		return nil, ErrInvalidSlot
+	}
+
+	now := time.Now().UTC()
+
+	if slot.StartsAt.Before(now) {
+		return nil, ErrInvalidSlot
+	}
+
+	// the schedule may have changed since the slot was published
+	err = s.checkSlotSchedule(ctx, *slot)
+	if err != nil {
+		return nil, err
+	}
+
+	// bookings of the same performer are serialized, so two residents can not take overlapping visits
+	err = s.repo.LockWorker(ctx, slot.WorkerID)
+	if err != nil {
+		return nil, err
+	}
+
+	overlaps, err := s.repo.HasOverlappingVisitSlot(ctx, *slot, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if overlaps {
+		return nil, ErrSlotConflict
+	}
+
+	err = s.repo.ReleaseVisitSlots(ctx, current.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.BookVisitSlot(ctx, slot.ID, current.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.updateApplication(ctx, user.ID, current, Application{ID: current.ID, PerformerTime: &slot.StartsAt}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetApplication(ctx, current.ID)
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"fmt"
+	"time"
+)
+
+// applicationTransitions describes the application lifecycle: for every
+// status it lists the statuses it may move to and the roles allowed to do it.
+var applicationTransitions = map[ApplicationStatus]map[ApplicationStatus][]UserRole{
+	ApplStatusCreated: {
+		ApplStatusInProgress: {UserRoleWorker, UserRoleModerator},
+		ApplStatusCancelled:  {UserRoleUser, UserRoleModerator},
+		ApplStatusRejected:   {UserRoleModerator},
+	},
+	ApplStatusInProgress: {
+		ApplStatusDone:      {UserRoleWorker, UserRoleModerator},
+		ApplStatusCancelled: {UserRoleUser, UserRoleModerator},
+		ApplStatusRejected:  {UserRoleModerator},
+	},
+	ApplStatusDone: {
+		ApplStatusReopened: {UserRoleUser, UserRoleModerator},
+	},
+	ApplStatusReopened: {
+		ApplStatusInProgress: {UserRoleWorker, UserRoleModerator},
+		ApplStatusCancelled:  {UserRoleUser, UserRoleModerator},
+		ApplStatusRejected:   {UserRoleModerator},
+	},
+	ApplStatusCancelled: {},
+	ApplStatusRejected:  {},
+}
+
+// TransitionError is returned when an application can not be moved
+// from one status to another.
+type TransitionError struct {
+	From   ApplicationStatus
+	To     ApplicationStatus
+	Role   UserRole
+	Reason string
+}
+
+func (e *TransitionError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("transition from %q to %q: %s", e.From, e.To, e.Reason)
+	}
+
+	return fmt.Sprintf("transition from %q to %q is not allowed for role %q", e.From, e.To, e.Role)
+}
+
+func (s ApplicationStatus) IsValid() bool {
+	_, ok := applicationTransitions[s]
+	return ok
+}
+
+// CanTransition checks that the role may move an application from one status to another.
+func CanTransition(from, to ApplicationStatus, role UserRole) error {
+	if !to.IsValid() {
+		return &TransitionError{From: from, To: to, Role: role, Reason: "unknown status"}
+	}
+
+	for _, allowed := range applicationTransitions[from][to] {
+		if allowed == role {
+			return nil
+		}
+	}
+
+	return &TransitionError{From: from, To: to, Role: role}
+}
+
+func (s *Service) checkApplicationTransition(current *Application, update Application, user *User) error {
+	if update.Status == "" || update.Status == current.Status {
+		return nil
+	}
+
+	err := CanTransition(current.Status, update.Status, user.Role)
+	if err != nil {
+		return err
+	}
+
+	if user.Role == UserRoleWorker && (current.PerformerID == nil || *current.PerformerID != user.ID) {
+		return &TransitionError{From: current.Status, To: update.Status, Role: user.Role, Reason: "application is assigned to another performer"}
+	}
+
+	if update.Status == ApplStatusInProgress && current.PerformerID == nil && update.PerformerID == nil {
+		return &TransitionError{From: current.Status, To: update.Status, Role: user.Role, Reason: "performer is not set"}
+	}
+
+	if user.Role == UserRoleUser && current.CreatorID != user.ID {
+		return &TransitionError{From: current.Status, To: update.Status, Role: user.Role, Reason: "application is created by another user"}
+	}
+
+	if update.Status == ApplStatusReopened && (current.DoneAt == nil || time.Since(*current.DoneAt) > s.getReopenWindow()) {
+		return &TransitionError{From: current.Status, To: update.Status, Role: user.Role, Reason: "reopen window is over"}
+	}
+
+	return nil
+}
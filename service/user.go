@@ -9,6 +9,17 @@ import (
 
 type UserRole string
 
+const (
+	UserRoleUser      UserRole = "user"
+	UserRoleModerator UserRole = "moderator"
+	UserRoleWorker    UserRole = "worker"
+)
+
+// IsStaff reports whether the role belongs to the management company staff.
+func (r UserRole) IsStaff() bool {
+	return r == UserRoleModerator || r == UserRoleWorker
+}
+
 type User struct {
 	ID        uuid.UUID
 	CreatedAt time.Time
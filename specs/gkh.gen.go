@@ -4,21 +4,94 @@
 package specs
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/go-chi/chi/v5"
 )
 
+// Defines values for AbsenceReason.
+const (
+	AbsenceReasonDayOff AbsenceReason = "day_off"
+
+	AbsenceReasonSickLeave AbsenceReason = "sick_leave"
+
+	AbsenceReasonVacation AbsenceReason = "vacation"
+)
+
+// Defines values for ApplicationEventField.
+const (
+	ApplicationEventFieldDuplicateOf ApplicationEventField = "duplicate_of"
+
+	ApplicationEventFieldPerformerId ApplicationEventField = "performer_id"
+
+	ApplicationEventFieldPerformerTime ApplicationEventField = "performer_time"
+
+	ApplicationEventFieldPriority ApplicationEventField = "priority"
+
+	ApplicationEventFieldStatus ApplicationEventField = "status"
+)
+
+// Defines values for ApplicationPriority.
+const (
+	ApplicationPriorityEmergency ApplicationPriority = "emergency"
+
+	ApplicationPriorityHigh ApplicationPriority = "high"
+
+	ApplicationPriorityLow ApplicationPriority = "low"
+
+	ApplicationPriorityNormal ApplicationPriority = "normal"
+)
+
 // Defines values for ApplicationStatus.
 const (
+	ApplicationStatusCancelled ApplicationStatus = "cancelled"
+
 	ApplicationStatusCreated ApplicationStatus = "created"
 
 	ApplicationStatusDone ApplicationStatus = "done"
 
 	ApplicationStatusInProgress ApplicationStatus = "in_progress"
+
+	ApplicationStatusRejected ApplicationStatus = "rejected"
+
+	ApplicationStatusReopened ApplicationStatus = "reopened"
+)
+
+// Defines values for BulkUpdateApplicationsPayloadMode.
+const (
+	BulkUpdateApplicationsPayloadModeAtomic BulkUpdateApplicationsPayloadMode = "atomic"
+
+	BulkUpdateApplicationsPayloadModePerItem BulkUpdateApplicationsPayloadMode = "per_item"
+)
+
+// Defines values for BulkUpdateApplicationsResponseMode.
+const (
+	BulkUpdateApplicationsResponseModeAtomic BulkUpdateApplicationsResponseMode = "atomic"
+
+	BulkUpdateApplicationsResponseModePerItem BulkUpdateApplicationsResponseMode = "per_item"
+)
+
+// Defines values for BulkUpdateItemResultResult.
+const (
+	BulkUpdateItemResultResultApplied BulkUpdateItemResultResult = "applied"
+
+	BulkUpdateItemResultResultFailed BulkUpdateItemResultResult = "failed"
+
+	BulkUpdateItemResultResultRolledBack BulkUpdateItemResultResult = "rolled_back"
+
+	BulkUpdateItemResultResultSkipped BulkUpdateItemResultResult = "skipped"
+)
+
+// Defines values for PhotoSize.
+const (
+	PhotoSizeOriginal PhotoSize = "original"
+
+	PhotoSizeThumbnail PhotoSize = "thumbnail"
 )
 
 // Defines values for UserRole.
@@ -30,19 +103,171 @@ const (
 	UserRoleWorker UserRole = "worker"
 )
 
+// Defines values for WorkingHoursWeekday.
+const (
+	WorkingHoursWeekdayFriday WorkingHoursWeekday = "friday"
+
+	WorkingHoursWeekdayMonday WorkingHoursWeekday = "monday"
+
+	WorkingHoursWeekdaySaturday WorkingHoursWeekday = "saturday"
+
+	WorkingHoursWeekdaySunday WorkingHoursWeekday = "sunday"
+
+	WorkingHoursWeekdayThursday WorkingHoursWeekday = "thursday"
+
+	WorkingHoursWeekdayTuesday WorkingHoursWeekday = "tuesday"
+
+	WorkingHoursWeekdayWednesday WorkingHoursWeekday = "wednesday"
+)
+
+// AbsenceReason defines model for AbsenceReason.
+type AbsenceReason string
+
+// Квартира.
+type ApartmentResponse struct {
+	BuildingId string  `json:"building_id"`
+	EntranceId *string `json:"entrance_id,omitempty"`
+	Floor      *int    `json:"floor,omitempty"`
+	Id         string  `json:"id"`
+	Number     string  `json:"number"`
+}
+
+// ApplicationChecklistItemResponse defines model for ApplicationChecklistItemResponse.
+type ApplicationChecklistItemResponse struct {
+	ApplicationId string     `json:"application_id"`
+	Done          bool       `json:"done"`
+	DoneAt        *time.Time `json:"done_at,omitempty"`
+	DoneBy        *string    `json:"done_by,omitempty"`
+	Id            string     `json:"id"`
+	Required      bool       `json:"required"`
+	Title         string     `json:"title"`
+}
+
+// ApplicationChecklistResponse defines model for ApplicationChecklistResponse.
+type ApplicationChecklistResponse struct {
+	ApplicationId string                             `json:"application_id"`
+	Items         []ApplicationChecklistItemResponse `json:"items"`
+}
+
+// Сущность комментария к заявке.
+type ApplicationCommentResponse struct {
+	ApplicationId string    `json:"application_id"`
+	AuthorId      string    `json:"author_id"`
+	CreatedAt     time.Time `json:"created_at"`
+	Id            string    `json:"id"`
+	Internal      bool      `json:"internal"`
+	Text          string    `json:"text"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Изменение заявки.
+type ApplicationEvent struct {
+	ApplicationId string `json:"application_id"`
+
+	// Автор изменения. Отсутствует, если изменение сделано системой.
+	AuthorId  *string               `json:"author_id,omitempty"`
+	Comment   *string               `json:"comment,omitempty"`
+	CreatedAt time.Time             `json:"created_at"`
+	Field     ApplicationEventField `json:"field"`
+	Id        string                `json:"id"`
+	NewValue  *string               `json:"new_value,omitempty"`
+	OldValue  *string               `json:"old_value,omitempty"`
+}
+
+// ApplicationEventField defines model for ApplicationEventField.
+type ApplicationEventField string
+
+// Материал или трудозатраты по заявке.
+type ApplicationLineItemResponse struct {
+	ApplicationId string `json:"application_id"`
+	AuthorId      string `json:"author_id"`
+
+	// Стоимость материала в копейках.
+	Cost        int64     `json:"cost"`
+	CreatedAt   time.Time `json:"created_at"`
+	Id          string    `json:"id"`
+	LabourHours float64   `json:"labour_hours"`
+
+	// Название материала, не заполняется для трудозатрат без материалов.
+	Material string  `json:"material"`
+	Quantity float64 `json:"quantity"`
+
+	// Цена единицы материала в копейках.
+	UnitPrice int64     `json:"unit_price"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ApplicationPriority defines model for ApplicationPriority.
+type ApplicationPriority string
+
 // Сущность заявки
 type ApplicationResponse struct {
-	CreatedAt   time.Time         `json:"created_at"`
-	CreatorId   string            `json:"creator_id"`
-	Id          string            `json:"id"`
-	PerformerAt *time.Time        `json:"performer_at,omitempty"`
-	PerformerId *string           `json:"performer_id,omitempty"`
-	PhotoIds    []string          `json:"photo_ids"`
-	Status      ApplicationStatus `json:"status"`
-	Subtype     string            `json:"subtype"`
-	Text        string            `json:"text"`
-	Type        string            `json:"type"`
-	UpdatedAt   time.Time         `json:"updated_at"`
+	ApartmentId *string `json:"apartment_id,omitempty"`
+
+	// Не заполнен у заявок, созданных до появления справочника домов.
+	BuildingId *string   `json:"building_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	CreatorId  string    `json:"creator_id"`
+
+	// Время последнего выполнения заявки.
+	DoneAt *time.Time `json:"done_at,omitempty"`
+
+	// Срок, до которого заявка должна быть выполнена.
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// Открытые заявки, похожие на созданную. Заполняется только при создании заявки.
+	DuplicateCandidates *[]DuplicateCandidate `json:"duplicate_candidates,omitempty"`
+
+	// Заявка, дубликатом которой признана эта заявка.
+	DuplicateOf *string `json:"duplicate_of,omitempty"`
+
+	// Дополнительные поля заявки.
+	Fields *ApplicationResponse_Fields `json:"fields,omitempty"`
+	Id     string                      `json:"id"`
+
+	// Трудозатраты по заявке в часах.
+	LabourHours float64 `json:"labour_hours"`
+
+	// Широта дома заявки.
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Долгота дома заявки.
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Стоимость материалов по заявке в копейках.
+	MaterialsCost int64 `json:"materials_cost"`
+
+	// Заявка не взята в работу или не выполнена в срок.
+	Overdue     bool                `json:"overdue"`
+	PerformerAt *time.Time          `json:"performer_at,omitempty"`
+	PerformerId *string             `json:"performer_id,omitempty"`
+	PhotoIds    []string            `json:"photo_ids"`
+	Priority    ApplicationPriority `json:"priority"`
+	RatedAt     *time.Time          `json:"rated_at,omitempty"`
+	Rating      *int                `json:"rating,omitempty"`
+
+	// Шаблон плановых работ, по которому создана заявка.
+	RecurrenceId *string `json:"recurrence_id,omitempty"`
+
+	// Сколько раз заявка открывалась повторно.
+	ReopenCount int `json:"reopen_count"`
+
+	// Срок, до которого заявка должна быть взята в работу.
+	ResponseDueAt *time.Time        `json:"response_due_at,omitempty"`
+	Review        *string           `json:"review,omitempty"`
+	Status        ApplicationStatus `json:"status"`
+	Subtype       string            `json:"subtype"`
+	Text          string            `json:"text"`
+	Type          string            `json:"type"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+
+	// Время, в которое исполнитель может прийти по заявке.
+	VisitSlot *VisitSlotResponse `json:"visit_slot,omitempty"`
+}
+
+// Дополнительные поля заявки.
+type ApplicationResponse_Fields struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
 // ApplicationStatus defines model for ApplicationStatus.
@@ -50,23 +275,305 @@ type ApplicationStatus string
 
 // Сущность пользователя.
 type ApplicationSubtype struct {
-	Id    string `json:"id"`
+	// Время переноса в архив, архивный подтип недоступен для новых заявок.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	Id         string     `json:"id"`
+
+	// Порядок подтипа в списках подтипов типа.
+	Position int `json:"position"`
+
+	// Время выполнения заявки в минутах.
+	ResolutionMinutes *int `json:"resolution_minutes,omitempty"`
+
+	// Время реакции на заявку в минутах.
+	ResponseMinutes *int `json:"response_minutes,omitempty"`
+
+	// Название на предпочтительном языке запроса.
 	Title string `json:"title"`
-	Type  string `json:"type"`
+
+	// Переводы названия по языкам, например {"en":"Plumbing"}.
+	Titles *ApplicationSubtype_Titles `json:"titles,omitempty"`
+	Type   string                     `json:"type"`
+}
+
+// Переводы названия по языкам, например {"en":"Plumbing"}.
+type ApplicationSubtype_Titles struct {
+	AdditionalProperties map[string]string `json:"-"`
 }
 
 // Сущность пользователя.
 type ApplicationType struct {
-	Id    string `json:"id"`
+	// Время переноса в архив, архивный тип недоступен для новых заявок.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// JSON Schema дополнительных полей заявок этого типа. Не заполняется, если у типа нет дополнительных полей.
+	FieldsSchema *ApplicationType_FieldsSchema `json:"fields_schema,omitempty"`
+	Id           string                        `json:"id"`
+
+	// Порядок типа в списках.
+	Position int `json:"position"`
+
+	// Название на предпочтительном языке запроса.
 	Title string `json:"title"`
+
+	// Переводы названия по языкам, например {"en":"Plumbing"}.
+	Titles *ApplicationType_Titles `json:"titles,omitempty"`
+}
+
+// JSON Schema дополнительных полей заявок этого типа. Не заполняется, если у типа нет дополнительных полей.
+type ApplicationType_FieldsSchema struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Переводы названия по языкам, например {"en":"Plumbing"}.
+type ApplicationType_Titles struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Параметры запроса на назначение исполнителя.
+type AssignPerformerPayload struct {
+	PerformerId string `json:"performer_id"`
+}
+
+// Параметры запроса на бронирование слота.
+type BookVisitSlotPayload struct {
+	SlotId string `json:"slot_id"`
+}
+
+// Дом.
+type BuildingResponse struct {
+	Address   string    `json:"address"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        string    `json:"id"`
+	Latitude  *float64  `json:"latitude,omitempty"`
+	Longitude *float64  `json:"longitude,omitempty"`
+}
+
+// Условия отбора заявок для массового изменения, как в списке заявок. Нужно хотя бы одно условие.
+type BulkApplicationFilter struct {
+	// Квартира заявок.
+	ApartmentId *string `json:"apartment_id,omitempty"`
+
+	// Здание заявок.
+	BuildingId *string `json:"building_id,omitempty"`
+
+	// Автор заявок.
+	CreatorId *string `json:"creator_id,omitempty"`
+
+	// Значения дополнительных полей, которые должны быть у заявок.
+	Fields *BulkApplicationFilter_Fields `json:"fields,omitempty"`
+
+	// Только просроченные или только не просроченные заявки.
+	Overdue *bool `json:"overdue,omitempty"`
+
+	// Исполнитель заявок.
+	PerformerId *string              `json:"performer_id,omitempty"`
+	Priority    *ApplicationPriority `json:"priority,omitempty"`
+
+	// Полнотекстовый поиск по тексту заявки.
+	Q *string `json:"q,omitempty"`
+
+	// Шаблон планового обслуживания, по которому созданы заявки.
+	RecurrenceId *string            `json:"recurrence_id,omitempty"`
+	Status       *ApplicationStatus `json:"status,omitempty"`
+
+	// Тип заявок.
+	Type *string `json:"type,omitempty"`
+}
+
+// Значения дополнительных полей, которые должны быть у заявок.
+type BulkApplicationFilter_Fields struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Параметры массового изменения заявок. Заявки задаются списком идентификаторов или условиями отбора.
+type BulkUpdateApplicationsPayload struct {
+	// Условия отбора заявок для массового изменения, как в списке заявок. Нужно хотя бы одно условие.
+	Filter *BulkApplicationFilter `json:"filter,omitempty"`
+	Ids    *[]string              `json:"ids,omitempty"`
+
+	// atomic применяет изменение ко всем заявкам в одной транзакции и откатывает его при первой ошибке, per_item применяет изменение к каждой заявке отдельно.
+	Mode        *BulkUpdateApplicationsPayloadMode `json:"mode,omitempty"`
+	PerformerId *string                            `json:"performer_id,omitempty"`
+	Priority    *ApplicationPriority               `json:"priority,omitempty"`
+	Status      *ApplicationStatus                 `json:"status,omitempty"`
+}
+
+// atomic применяет изменение ко всем заявкам в одной транзакции и откатывает его при первой ошибке, per_item применяет изменение к каждой заявке отдельно.
+type BulkUpdateApplicationsPayloadMode string
+
+// Отчет о массовом изменении заявок.
+type BulkUpdateApplicationsResponse struct {
+	// Количество измененных заявок.
+	Applied int `json:"applied"`
+
+	// Количество заявок с ошибкой.
+	Failed int                                `json:"failed"`
+	Items  []BulkUpdateItemResult             `json:"items"`
+	Mode   BulkUpdateApplicationsResponseMode `json:"mode"`
+}
+
+// BulkUpdateApplicationsResponseMode defines model for BulkUpdateApplicationsResponse.Mode.
+type BulkUpdateApplicationsResponseMode string
+
+// Результат изменения одной заявки.
+type BulkUpdateItemResult struct {
+	ApplicationId string `json:"application_id"`
+
+	// HTTP код ошибки изменения заявки.
+	Code *int `json:"code,omitempty"`
+
+	// Причина ошибки.
+	Reason *string `json:"reason,omitempty"`
+
+	// applied - заявка изменена, failed - изменение не удалось, rolled_back - изменение отменено из-за ошибки в другой заявке, skipped - заявка не обработана после ошибки в другой заявке.
+	Result BulkUpdateItemResultResult `json:"result"`
+}
+
+// applied - заявка изменена, failed - изменение не удалось, rolled_back - изменение отменено из-за ошибки в другой заявке, skipped - заявка не обработана после ошибки в другой заявке.
+type BulkUpdateItemResultResult string
+
+// Параметры запроса на отзыв или повторное открытие заявки.
+type ChangeApplicationStatusPayload struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// Шаг работ по заявкам подтипа.
+type ChecklistItem struct {
+	// Обязательные шаги должны быть выполнены до завершения заявки
+	Required bool   `json:"required"`
+	Title    string `json:"title"`
+}
+
+// Стоимость материалов и трудозатраты по типам заявок.
+type CostReportResponse struct {
+	Types []CostStat `json:"types"`
+}
+
+// Стоимость материалов и трудозатраты по заявкам.
+type CostStat struct {
+	// Количество заявок с материалами или трудозатратами.
+	Count int `json:"count"`
+
+	// Идентификатор типа заявки.
+	Id          string  `json:"id"`
+	LabourHours float64 `json:"labour_hours"`
+
+	// Стоимость материалов в копейках.
+	MaterialsCost int64 `json:"materials_cost"`
+}
+
+// Параметры запроса на создание квартиры.
+type CreateApartmentPayload struct {
+	EntranceId *string `json:"entrance_id,omitempty"`
+	Floor      *int    `json:"floor,omitempty"`
+	Number     string  `json:"number"`
+}
+
+// Параметры запроса на создание комментария.
+type CreateApplicationCommentPayload struct {
+	// Комментарий виден только модераторам и исполнителям.
+	Internal *bool  `json:"internal,omitempty"`
+	Text     string `json:"text"`
+}
+
+// Параметры запроса на добавление материала или трудозатрат.
+type CreateApplicationLineItemPayload struct {
+	LabourHours *float64 `json:"labour_hours,omitempty"`
+
+	// Название материала, не заполняется для трудозатрат без материалов.
+	Material *string  `json:"material,omitempty"`
+	Quantity *float64 `json:"quantity,omitempty"`
+
+	// Цена единицы материала в копейках.
+	UnitPrice *int64 `json:"unit_price,omitempty"`
 }
 
 // Параметры запроса на создание заявки.
 type CreateApplicationPayload struct {
-	PhotoIds *[]string `json:"photo_ids,omitempty"`
-	Subtype  string    `json:"subtype"`
-	Text     string    `json:"text"`
-	Type     string    `json:"type"`
+	// Не заполняется для заявок по местам общего пользования.
+	ApartmentId *string `json:"apartment_id,omitempty"`
+	BuildingId  string  `json:"building_id"`
+
+	// Дополнительные поля заявки, проверяются по JSON Schema типа заявки.
+	Fields   *CreateApplicationPayload_Fields `json:"fields,omitempty"`
+	PhotoIds *[]string                        `json:"photo_ids,omitempty"`
+	Priority *ApplicationPriority             `json:"priority,omitempty"`
+	Subtype  string                           `json:"subtype"`
+	Text     string                           `json:"text"`
+	Type     string                           `json:"type"`
+}
+
+// Дополнительные поля заявки, проверяются по JSON Schema типа заявки.
+type CreateApplicationPayload_Fields struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Параметры запроса на создание подтипа заявок.
+type CreateApplicationSubTypePayload struct {
+	// Время выполнения заявки в минутах.
+	ResolutionMinutes *int `json:"resolution_minutes,omitempty"`
+
+	// Время реакции на заявку в минутах.
+	ResponseMinutes *int `json:"response_minutes,omitempty"`
+
+	// Название по умолчанию.
+	Title string `json:"title"`
+
+	// Переводы названия по языкам, например {"en":"Plumbing"}.
+	Titles *CreateApplicationSubTypePayload_Titles `json:"titles,omitempty"`
+	Type   string                                  `json:"type"`
+}
+
+// Переводы названия по языкам, например {"en":"Plumbing"}.
+type CreateApplicationSubTypePayload_Titles struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Параметры запроса на создание типа заявок.
+type CreateApplicationTypePayload struct {
+	// JSON Schema дополнительных полей заявок этого типа.
+	FieldsSchema *CreateApplicationTypePayload_FieldsSchema `json:"fields_schema,omitempty"`
+
+	// Название по умолчанию.
+	Title string `json:"title"`
+
+	// Переводы названия по языкам, например {"en":"Plumbing"}.
+	Titles *CreateApplicationTypePayload_Titles `json:"titles,omitempty"`
+}
+
+// JSON Schema дополнительных полей заявок этого типа.
+type CreateApplicationTypePayload_FieldsSchema struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Переводы названия по языкам, например {"en":"Plumbing"}.
+type CreateApplicationTypePayload_Titles struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Параметры запроса на создание дома.
+type CreateBuildingPayload struct {
+	Address   string   `json:"address"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// Параметры запроса на создание подъезда.
+type CreateEntrancePayload struct {
+	Number string `json:"number"`
+}
+
+// Параметры запроса на создание шаблона плановых работ.
+type CreateMaintenanceTemplatePayload struct {
+	ApartmentId *string              `json:"apartment_id,omitempty"`
+	BuildingId  string               `json:"building_id"`
+	PerformerId *string              `json:"performer_id,omitempty"`
+	Priority    *ApplicationPriority `json:"priority,omitempty"`
+	Schedule    string               `json:"schedule"`
+	Subtype     string               `json:"subtype"`
+	Text        string               `json:"text"`
+	Type        string               `json:"type"`
 }
 
 // c
@@ -77,6 +584,40 @@ type CreateUserPayload struct {
 	Role      UserRole `json:"role"`
 }
 
+// Параметры запроса на публикацию слота.
+type CreateVisitSlotPayload struct {
+	EndsAt   time.Time `json:"ends_at"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// Параметры запроса на добавление отсутствия.
+type CreateWorkerAbsencePayload struct {
+	Comment  *string            `json:"comment,omitempty"`
+	EndsOn   openapi_types.Date `json:"ends_on"`
+	Reason   AbsenceReason      `json:"reason"`
+	StartsOn openapi_types.Date `json:"starts_on"`
+}
+
+// Параметры запроса на отказ от заявки.
+type DeclineAssignmentPayload struct {
+	Reason string `json:"reason"`
+}
+
+// Открытая заявка, которая может быть дубликатом. Текст заявки видят только модераторы.
+type DuplicateCandidate struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Id        string            `json:"id"`
+	Status    ApplicationStatus `json:"status"`
+	Text      *string           `json:"text,omitempty"`
+}
+
+// Подъезд дома.
+type EntranceResponse struct {
+	BuildingId string `json:"building_id"`
+	Id         string `json:"id"`
+	Number     string `json:"number"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Code    int     `json:"code"`
@@ -84,6 +625,38 @@ type Error struct {
 	Message string  `json:"message"`
 }
 
+// Ответ на запрос на получение списка квартир.
+type ListApartmentsResponse struct {
+	Data []ApartmentResponse `json:"data"`
+
+	// Полное количество элементов, попадающих под параметра запроса.
+	Meta ResponseMetaTotal `json:"meta"`
+}
+
+// Ответ на запрос на получение комментариев к заявке.
+type ListApplicationCommentsResponse struct {
+	Data []ApplicationCommentResponse `json:"data"`
+
+	// Полное количество элементов, попадающих под параметра запроса.
+	Meta ResponseMetaTotal `json:"meta"`
+}
+
+// Ответ на запрос на получение истории изменений заявки.
+type ListApplicationHistoryResponse struct {
+	Data []ApplicationEvent `json:"data"`
+
+	// Полное количество элементов, попадающих под параметра запроса.
+	Meta ResponseMetaTotal `json:"meta"`
+}
+
+// Ответ на запрос на получение материалов и трудозатрат.
+type ListApplicationLineItemsResponse struct {
+	Data []ApplicationLineItemResponse `json:"data"`
+
+	// Полное количество элементов, попадающих под параметра запроса.
+	Meta ResponseMetaTotal `json:"meta"`
+}
+
 // Ответ на запрос на получение списка заявок.
 type ListApplicationResponse struct {
 	Data []ApplicationResponse `json:"data"`
@@ -108,6 +681,30 @@ type ListApplicationTypes struct {
 	Meta ResponseMetaTotal `json:"meta"`
 }
 
+// Ответ на запрос на получение списка домов.
+type ListBuildingsResponse struct {
+	Data []BuildingResponse `json:"data"`
+
+	// Полное количество элементов, попадающих под параметра запроса.
+	Meta ResponseMetaTotal `json:"meta"`
+}
+
+// Ответ на запрос на получение списка подъездов.
+type ListEntrancesResponse struct {
+	Data []EntranceResponse `json:"data"`
+
+	// Полное количество элементов, попадающих под параметра запроса.
+	Meta ResponseMetaTotal `json:"meta"`
+}
+
+// Ответ на запрос на получение списка шаблонов плановых работ.
+type ListMaintenanceTemplatesResponse struct {
+	Data []MaintenanceTemplateResponse `json:"data"`
+
+	// Полное количество элементов, попадающих под параметра запроса.
+	Meta ResponseMetaTotal `json:"meta"`
+}
+
 // Ответ на запрос на получение списка пользователей.
 type ListUsersResponse struct {
 	Data []UserResponse `json:"data"`
@@ -116,55 +713,400 @@ type ListUsersResponse struct {
 	Meta ResponseMetaTotal `json:"meta"`
 }
 
-// Полное количество элементов, попадающих под параметра запроса.
-type ResponseMetaTotal struct {
-	Total int `json:"total"`
-}
+// Ответ на запрос на получение списка слотов.
+type ListVisitSlotsResponse struct {
+	Data []VisitSlotResponse `json:"data"`
 
-// Параметры запроса на редактирование пользователя.
-type UpdateApplicationPayload struct {
-	PerformerId   *string            `json:"performer_id,omitempty"`
-	PerformerTime *time.Time         `json:"performer_time,omitempty"`
-	Status        *ApplicationStatus `json:"status,omitempty"`
+	// Полное количество элементов, попадающих под параметра запроса.
+	Meta ResponseMetaTotal `json:"meta"`
 }
 
-// Сущность пользователя.
-type UserResponse struct {
-	CreatedAt time.Time `json:"created_at"`
-	FirstName string    `json:"first_name"`
-	Id        string    `json:"id"`
-	LastName  string    `json:"last_name"`
-	Phone     string    `json:"phone"`
-	Role      UserRole  `json:"role"`
+// Ответ на запрос на получение списка отсутствий.
+type ListWorkerAbsencesResponse struct {
+	Data []WorkerAbsenceResponse `json:"data"`
+
+	// Полное количество элементов, попадающих под параметра запроса.
+	Meta ResponseMetaTotal `json:"meta"`
 }
 
-// UserRole defines model for UserRole.
-type UserRole string
+// MaintenanceTemplateResponse defines model for MaintenanceTemplateResponse.
+type MaintenanceTemplateResponse struct {
+	Active      bool      `json:"active"`
+	ApartmentId *string   `json:"apartment_id,omitempty"`
+	BuildingId  string    `json:"building_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	CreatorId   string    `json:"creator_id"`
+	Id          string    `json:"id"`
+
+	// Причина неудачи последнего запуска, сбрасывается успешным запуском.
+	LastError *string    `json:"last_error,omitempty"`
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+
+	// Время создания следующей заявки, не заполняется у приостановленных шаблонов.
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+
+	// Исполнитель создаваемых заявок, если он на работе.
+	PerformerId *string             `json:"performer_id,omitempty"`
+	Priority    ApplicationPriority `json:"priority"`
+
+	// Расписание в формате cron - минута, час, день месяца, месяц, день недели.
+	Schedule string `json:"schedule"`
+	Subtype  string `json:"subtype"`
+	Text     string `json:"text"`
+	Type     string `json:"type"`
+}
 
-// Pagination defines model for pagination.
-type Pagination struct {
-	// Количество элементов на странице.
-	Limit int `json:"limit"`
+// Параметры запроса на закрытие заявки как дубликата.
+type MarkDuplicatePayload struct {
+	OriginalId string `json:"original_id"`
+}
 
-	// Количество элементов, которые нужно пропустить от начала списка.
-	Offset int `json:"offset"`
+// Сущность фотографии.
+type PhotoResponse struct {
+	ContentType string    `json:"content_type"`
+	CreatedAt   time.Time `json:"created_at"`
+	CreatorId   string    `json:"creator_id"`
+	Id          string    `json:"id"`
+	Size        int64     `json:"size"`
 }
 
-// Sort defines model for sort.
-type Sort struct {
-	// Свойство, по которому нужно сортировать массив.
-	SortKey string `json:"sortKey"`
+// PhotoSize defines model for PhotoSize.
+type PhotoSize string
 
-	// Возможные значения порядка сортировки.
-	SortOrder string `json:"sortOrder"`
+// Параметры запроса на оценку заявки.
+type RateApplicationPayload struct {
+	Rating int     `json:"rating"`
+	Review *string `json:"review,omitempty"`
 }
 
-// CreateApplicationJSONBody defines parameters for CreateApplication.
+// Средние оценки по исполнителям и типам заявок.
+type RatingReportResponse struct {
+	Types   []RatingStat `json:"types"`
+	Workers []RatingStat `json:"workers"`
+}
+
+// Средняя оценка заявок.
+type RatingStat struct {
+	Average float64 `json:"average"`
+	Count   int     `json:"count"`
+
+	// Идентификатор исполнителя или типа заявки.
+	Id string `json:"id"`
+}
+
+// Идентификаторы в новом порядке.
+type ReorderPayload struct {
+	Ids []string `json:"ids"`
+}
+
+// Полное количество элементов, попадающих под параметра запроса.
+type ResponseMetaTotal struct {
+	Total int `json:"total"`
+}
+
+// Отметка о выполнении шага чек-листа.
+type SetApplicationChecklistItemPayload struct {
+	Done bool `json:"done"`
+}
+
+// SetArchivedPayload defines model for SetArchivedPayload.
+type SetArchivedPayload struct {
+	Archived bool `json:"archived"`
+}
+
+// Параметры запроса на замену чек-листа подтипа.
+type SetSubTypeChecklistPayload struct {
+	Items []ChecklistItem `json:"items"`
+}
+
+// Все квалификации исполнителя, пустой список исключает его из автоматического назначения.
+type SetWorkerQualificationsPayload struct {
+	Qualifications []WorkerQualification `json:"qualifications"`
+}
+
+// Параметры запроса на замену рабочих часов.
+type SetWorkerSchedulePayload struct {
+	Hours []WorkingHours `json:"hours"`
+}
+
+// Чек-лист подтипа, копируется в каждую новую заявку подтипа.
+type SubTypeChecklistResponse struct {
+	Items     []ChecklistItem `json:"items"`
+	SubtypeId string          `json:"subtype_id"`
+}
+
+// Параметры запроса на редактирование квартиры.
+type UpdateApartmentPayload struct {
+	EntranceId *string `json:"entrance_id,omitempty"`
+	Floor      *int    `json:"floor,omitempty"`
+	Number     *string `json:"number,omitempty"`
+}
+
+// Параметры запроса на редактирование комментария.
+type UpdateApplicationCommentPayload struct {
+	Internal *bool   `json:"internal,omitempty"`
+	Text     *string `json:"text,omitempty"`
+}
+
+// Параметры запроса на изменение материала или трудозатрат.
+type UpdateApplicationLineItemPayload struct {
+	LabourHours *float64 `json:"labour_hours,omitempty"`
+
+	// Название материала, не заполняется для трудозатрат без материалов.
+	Material *string  `json:"material,omitempty"`
+	Quantity *float64 `json:"quantity,omitempty"`
+
+	// Цена единицы материала в копейках.
+	UnitPrice *int64 `json:"unit_price,omitempty"`
+}
+
+// Параметры запроса на редактирование пользователя.
+type UpdateApplicationPayload struct {
+	AddPhotoIds *[]string `json:"add_photo_ids,omitempty"`
+
+	// Дополнительные поля заявки, заменяются целиком и проверяются по JSON Schema типа заявки.
+	Fields         *UpdateApplicationPayload_Fields `json:"fields,omitempty"`
+	PerformerId    *string                          `json:"performer_id,omitempty"`
+	PerformerTime  *time.Time                       `json:"performer_time,omitempty"`
+	Priority       *ApplicationPriority             `json:"priority,omitempty"`
+	RemovePhotoIds *[]string                        `json:"remove_photo_ids,omitempty"`
+	Status         *ApplicationStatus               `json:"status,omitempty"`
+}
+
+// Дополнительные поля заявки, заменяются целиком и проверяются по JSON Schema типа заявки.
+type UpdateApplicationPayload_Fields struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Параметры запроса на редактирование подтипа заявок.
+type UpdateApplicationSubTypePayload struct {
+	// Убрать время выполнения, нельзя передавать вместе с resolution_minutes.
+	ClearResolutionMinutes *bool `json:"clear_resolution_minutes,omitempty"`
+
+	// Убрать время реакции, нельзя передавать вместе с response_minutes.
+	ClearResponseMinutes *bool `json:"clear_response_minutes,omitempty"`
+
+	// Время выполнения заявки в минутах, применяется к новым заявкам.
+	ResolutionMinutes *int `json:"resolution_minutes,omitempty"`
+
+	// Время реакции на заявку в минутах, применяется к новым заявкам.
+	ResponseMinutes *int `json:"response_minutes,omitempty"`
+
+	// Название по умолчанию.
+	Title *string `json:"title,omitempty"`
+
+	// Все переводы названия по языкам, заменяют текущие, например {"en":"Plumbing"}.
+	Titles *UpdateApplicationSubTypePayload_Titles `json:"titles,omitempty"`
+}
+
+// Все переводы названия по языкам, заменяют текущие, например {"en":"Plumbing"}.
+type UpdateApplicationSubTypePayload_Titles struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Параметры запроса на редактирование типа заявок.
+type UpdateApplicationTypePayload struct {
+	// Удалить схему дополнительных полей, нельзя передавать вместе с fields_schema.
+	ClearFieldsSchema *bool `json:"clear_fields_schema,omitempty"`
+
+	// JSON Schema дополнительных полей, применяется к новым заявкам и к изменению полей.
+	FieldsSchema *UpdateApplicationTypePayload_FieldsSchema `json:"fields_schema,omitempty"`
+
+	// Название по умолчанию.
+	Title *string `json:"title,omitempty"`
+
+	// Все переводы названия по языкам, заменяют текущие, например {"en":"Plumbing"}.
+	Titles *UpdateApplicationTypePayload_Titles `json:"titles,omitempty"`
+}
+
+// JSON Schema дополнительных полей, применяется к новым заявкам и к изменению полей.
+type UpdateApplicationTypePayload_FieldsSchema struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Все переводы названия по языкам, заменяют текущие, например {"en":"Plumbing"}.
+type UpdateApplicationTypePayload_Titles struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Параметры запроса на редактирование дома.
+type UpdateBuildingPayload struct {
+	Address   *string  `json:"address,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// Параметры запроса на редактирование подъезда.
+type UpdateEntrancePayload struct {
+	Number *string `json:"number,omitempty"`
+}
+
+// Параметры запроса на изменение шаблона плановых работ.
+type UpdateMaintenanceTemplatePayload struct {
+	Active      *bool                `json:"active,omitempty"`
+	PerformerId *string              `json:"performer_id,omitempty"`
+	Priority    *ApplicationPriority `json:"priority,omitempty"`
+	Schedule    *string              `json:"schedule,omitempty"`
+	Text        *string              `json:"text,omitempty"`
+}
+
+// Сущность пользователя.
+type UserResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+	FirstName string    `json:"first_name"`
+	Id        string    `json:"id"`
+	LastName  string    `json:"last_name"`
+	Phone     string    `json:"phone"`
+	Role      UserRole  `json:"role"`
+}
+
+// UserRole defines model for UserRole.
+type UserRole string
+
+// Время, в которое исполнитель может прийти по заявке.
+type VisitSlotResponse struct {
+	// Заявка, для которой забронирован слот.
+	ApplicationId *string    `json:"application_id,omitempty"`
+	BookedAt      *time.Time `json:"booked_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	EndsAt        time.Time  `json:"ends_at"`
+	Id            string     `json:"id"`
+	StartsAt      time.Time  `json:"starts_at"`
+	WorkerId      string     `json:"worker_id"`
+}
+
+// WorkerAbsenceResponse defines model for WorkerAbsenceResponse.
+type WorkerAbsenceResponse struct {
+	Comment   *string   `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Последний день отсутствия
+	EndsOn   openapi_types.Date `json:"ends_on"`
+	Id       string             `json:"id"`
+	Reason   AbsenceReason      `json:"reason"`
+	StartsOn openapi_types.Date `json:"starts_on"`
+	WorkerId string             `json:"worker_id"`
+}
+
+// Тип и подтип заявок, которые назначаются исполнителю автоматически. Без подтипа подходят все подтипы типа.
+type WorkerQualification struct {
+	SubtypeId *string `json:"subtype_id,omitempty"`
+	TypeId    string  `json:"type_id"`
+}
+
+// WorkerQualificationsResponse defines model for WorkerQualificationsResponse.
+type WorkerQualificationsResponse struct {
+	Qualifications []WorkerQualification `json:"qualifications"`
+	WorkerId       string                `json:"worker_id"`
+}
+
+// Рабочие часы исполнителя. Исполнитель без рабочих часов доступен всегда.
+type WorkerScheduleResponse struct {
+	Hours    []WorkingHours `json:"hours"`
+	WorkerId string         `json:"worker_id"`
+}
+
+// Рабочий интервал в день недели, время в часовом поясе управляющей компании.
+type WorkingHours struct {
+	// Конец интервала, 24:00 означает конец дня
+	EndsAt   string              `json:"ends_at"`
+	StartsAt string              `json:"starts_at"`
+	Weekday  WorkingHoursWeekday `json:"weekday"`
+}
+
+// WorkingHoursWeekday defines model for WorkingHours.Weekday.
+type WorkingHoursWeekday string
+
+// Pagination defines model for pagination.
+type Pagination struct {
+	// Количество элементов на странице.
+	Limit int `json:"limit"`
+
+	// Количество элементов, которые нужно пропустить от начала списка.
+	Offset int `json:"offset"`
+}
+
+// Sort defines model for sort.
+type Sort struct {
+	// Свойство, по которому нужно сортировать массив.
+	SortKey string `json:"sortKey"`
+
+	// Возможные значения порядка сортировки.
+	SortOrder string `json:"sortOrder"`
+}
+
+// UpdateApartmentJSONBody defines parameters for UpdateApartment.
+type UpdateApartmentJSONBody UpdateApartmentPayload
+
+// CreateApplicationJSONBody defines parameters for CreateApplication.
 type CreateApplicationJSONBody CreateApplicationPayload
 
 // UpdateApplicationJSONBody defines parameters for UpdateApplication.
 type UpdateApplicationJSONBody UpdateApplicationPayload
 
+// AssignApplicationPerformerJSONBody defines parameters for AssignApplicationPerformer.
+type AssignApplicationPerformerJSONBody AssignPerformerPayload
+
+// CancelApplicationJSONBody defines parameters for CancelApplication.
+type CancelApplicationJSONBody ChangeApplicationStatusPayload
+
+// SetApplicationChecklistItemJSONBody defines parameters for SetApplicationChecklistItem.
+type SetApplicationChecklistItemJSONBody SetApplicationChecklistItemPayload
+
+// ListApplicationCommentsParams defines parameters for ListApplicationComments.
+type ListApplicationCommentsParams struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Sort       *Sort       `json:"sort,omitempty"`
+}
+
+// ListApplicationCommentsParamsSortSortOrder defines parameters for ListApplicationComments.
+type ListApplicationCommentsParamsSortSortOrder string
+
+// CreateApplicationCommentJSONBody defines parameters for CreateApplicationComment.
+type CreateApplicationCommentJSONBody CreateApplicationCommentPayload
+
+// UpdateApplicationCommentJSONBody defines parameters for UpdateApplicationComment.
+type UpdateApplicationCommentJSONBody UpdateApplicationCommentPayload
+
+// DeclineApplicationAssignmentJSONBody defines parameters for DeclineApplicationAssignment.
+type DeclineApplicationAssignmentJSONBody DeclineAssignmentPayload
+
+// MarkApplicationDuplicateJSONBody defines parameters for MarkApplicationDuplicate.
+type MarkApplicationDuplicateJSONBody MarkDuplicatePayload
+
+// ListApplicationHistoryParams defines parameters for ListApplicationHistory.
+type ListApplicationHistoryParams struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Sort       *Sort       `json:"sort,omitempty"`
+}
+
+// ListApplicationHistoryParamsSortSortOrder defines parameters for ListApplicationHistory.
+type ListApplicationHistoryParamsSortSortOrder string
+
+// ListApplicationLineItemsParams defines parameters for ListApplicationLineItems.
+type ListApplicationLineItemsParams struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Sort       *Sort       `json:"sort,omitempty"`
+}
+
+// ListApplicationLineItemsParamsSortSortOrder defines parameters for ListApplicationLineItems.
+type ListApplicationLineItemsParamsSortSortOrder string
+
+// CreateApplicationLineItemJSONBody defines parameters for CreateApplicationLineItem.
+type CreateApplicationLineItemJSONBody CreateApplicationLineItemPayload
+
+// UpdateApplicationLineItemJSONBody defines parameters for UpdateApplicationLineItem.
+type UpdateApplicationLineItemJSONBody UpdateApplicationLineItemPayload
+
+// RateApplicationJSONBody defines parameters for RateApplication.
+type RateApplicationJSONBody RateApplicationPayload
+
+// ReopenApplicationJSONBody defines parameters for ReopenApplication.
+type ReopenApplicationJSONBody ChangeApplicationStatusPayload
+
+// BookApplicationVisitSlotJSONBody defines parameters for BookApplicationVisitSlot.
+type BookApplicationVisitSlotJSONBody BookVisitSlotPayload
+
 // ListApplicationsParams defines parameters for ListApplications.
 type ListApplicationsParams struct {
 	// Идентификаторы иссполнителей, по которым нужно получить заявки.
@@ -177,7 +1119,40 @@ type ListApplicationsParams struct {
 	Status *ApplicationStatus `json:"status,omitempty"`
 
 	// Получение заявок по типу
-	Type       *string     `json:"type,omitempty"`
+	Type *string `json:"type,omitempty"`
+
+	// Получение заявок по приоритету
+	Priority *ApplicationPriority `json:"priority,omitempty"`
+
+	// Получение просроченных или не просроченных заявок
+	Overdue *bool `json:"overdue,omitempty"`
+
+	// Получение заявок по дому
+	BuildingId *string `json:"building_id,omitempty"`
+
+	// Получение заявок по квартире
+	ApartmentId *string `json:"apartment_id,omitempty"`
+
+	// Получение заявок, созданных по шаблону плановых работ
+	RecurrenceId *string `json:"recurrence_id,omitempty"`
+
+	// Получение заявок, дополнительные поля которых содержат значения JSON-объекта, например {"floor":3}
+	Fields *string `json:"fields,omitempty"`
+
+	// Получение заявок в прямоугольнике - широта и долгота юго-западного угла, широта и долгота северо-восточного угла.
+	Bbox *[]float64 `json:"bbox,omitempty"`
+
+	// Широта точки для поиска в радиусе и сортировки по расстоянию (ключ сортировки distance).
+	Lat *float64 `json:"lat,omitempty"`
+
+	// Долгота точки для поиска в радиусе и сортировки по расстоянию.
+	Lon *float64 `json:"lon,omitempty"`
+
+	// Радиус поиска в метрах от точки lat, lon.
+	Radius *float64 `json:"radius,omitempty"`
+
+	// Полнотекстовый поиск по тексту заявки. Для сортировки по релевантности используется ключ relevance.
+	Q          *string     `json:"q,omitempty"`
 	Pagination *Pagination `json:"pagination,omitempty"`
 	Sort       *Sort       `json:"sort,omitempty"`
 }
@@ -185,45 +1160,199 @@ type ListApplicationsParams struct {
 // ListApplicationsParamsSortSortOrder defines parameters for ListApplications.
 type ListApplicationsParamsSortSortOrder string
 
+// BulkUpdateApplicationsJSONBody defines parameters for BulkUpdateApplications.
+type BulkUpdateApplicationsJSONBody BulkUpdateApplicationsPayload
+
 // ListApplicationSubTypesParams defines parameters for ListApplicationSubTypes.
 type ListApplicationSubTypesParams struct {
 	TypeId *string `json:"typeId,omitempty"`
 
-	// Получение статей по строке
-	Search     *string     `json:"search,omitempty"`
-	Pagination *Pagination `json:"pagination,omitempty"`
-	Sort       *Sort       `json:"sort,omitempty"`
+	// Поиск подтипов заявок по названию
+	Search *string `json:"search,omitempty"`
+
+	// Включить архивные записи, по умолчанию скрыты
+	IncludeArchived *bool       `json:"include_archived,omitempty"`
+	Pagination      *Pagination `json:"pagination,omitempty"`
+	Sort            *Sort       `json:"sort,omitempty"`
+
+	// Предпочтительные языки названий, например "en-GB,en;q=0.8,ru;q=0.5". Без перевода используется название по умолчанию.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
 }
 
 // ListApplicationSubTypesParamsSortSortOrder defines parameters for ListApplicationSubTypes.
 type ListApplicationSubTypesParamsSortSortOrder string
 
+// CreateApplicationSubTypeJSONBody defines parameters for CreateApplicationSubType.
+type CreateApplicationSubTypeJSONBody CreateApplicationSubTypePayload
+
+// UpdateApplicationSubTypeJSONBody defines parameters for UpdateApplicationSubType.
+type UpdateApplicationSubTypeJSONBody UpdateApplicationSubTypePayload
+
+// SetApplicationSubTypeArchivedJSONBody defines parameters for SetApplicationSubTypeArchived.
+type SetApplicationSubTypeArchivedJSONBody SetArchivedPayload
+
+// SetSubTypeChecklistJSONBody defines parameters for SetSubTypeChecklist.
+type SetSubTypeChecklistJSONBody SetSubTypeChecklistPayload
+
 // ListApplicationTypesParams defines parameters for ListApplicationTypes.
 type ListApplicationTypesParams struct {
-	// Получение статей по строке
-	Search     *string     `json:"search,omitempty"`
-	Pagination *Pagination `json:"pagination,omitempty"`
-	Sort       *Sort       `json:"sort,omitempty"`
+	// Поиск типов заявок по названию
+	Search *string `json:"search,omitempty"`
+
+	// Включить архивные записи, по умолчанию скрыты
+	IncludeArchived *bool       `json:"include_archived,omitempty"`
+	Pagination      *Pagination `json:"pagination,omitempty"`
+	Sort            *Sort       `json:"sort,omitempty"`
+
+	// Предпочтительные языки названий, например "en-GB,en;q=0.8,ru;q=0.5". Без перевода используется название по умолчанию.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
 }
 
 // ListApplicationTypesParamsSortSortOrder defines parameters for ListApplicationTypes.
 type ListApplicationTypesParamsSortSortOrder string
 
+// CreateApplicationTypeJSONBody defines parameters for CreateApplicationType.
+type CreateApplicationTypeJSONBody CreateApplicationTypePayload
+
+// ReorderApplicationTypesJSONBody defines parameters for ReorderApplicationTypes.
+type ReorderApplicationTypesJSONBody ReorderPayload
+
+// UpdateApplicationTypeJSONBody defines parameters for UpdateApplicationType.
+type UpdateApplicationTypeJSONBody UpdateApplicationTypePayload
+
+// SetApplicationTypeArchivedJSONBody defines parameters for SetApplicationTypeArchived.
+type SetApplicationTypeArchivedJSONBody SetArchivedPayload
+
+// ReorderApplicationSubTypesJSONBody defines parameters for ReorderApplicationSubTypes.
+type ReorderApplicationSubTypesJSONBody ReorderPayload
+
+// CreateBuildingJSONBody defines parameters for CreateBuilding.
+type CreateBuildingJSONBody CreateBuildingPayload
+
+// UpdateBuildingJSONBody defines parameters for UpdateBuilding.
+type UpdateBuildingJSONBody UpdateBuildingPayload
+
+// ListApartmentsParams defines parameters for ListApartments.
+type ListApartmentsParams struct {
+	// Получение квартир подъезда
+	EntranceId *string     `json:"entrance_id,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Sort       *Sort       `json:"sort,omitempty"`
+}
+
+// ListApartmentsParamsSortSortOrder defines parameters for ListApartments.
+type ListApartmentsParamsSortSortOrder string
+
+// CreateApartmentJSONBody defines parameters for CreateApartment.
+type CreateApartmentJSONBody CreateApartmentPayload
+
+// ListEntrancesParams defines parameters for ListEntrances.
+type ListEntrancesParams struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Sort       *Sort       `json:"sort,omitempty"`
+}
+
+// ListEntrancesParamsSortSortOrder defines parameters for ListEntrances.
+type ListEntrancesParamsSortSortOrder string
+
+// CreateEntranceJSONBody defines parameters for CreateEntrance.
+type CreateEntranceJSONBody CreateEntrancePayload
+
+// ListBuildingsParams defines parameters for ListBuildings.
+type ListBuildingsParams struct {
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Sort       *Sort       `json:"sort,omitempty"`
+}
+
+// ListBuildingsParamsSortSortOrder defines parameters for ListBuildings.
+type ListBuildingsParamsSortSortOrder string
+
+// UpdateEntranceJSONBody defines parameters for UpdateEntrance.
+type UpdateEntranceJSONBody UpdateEntrancePayload
+
+// CreateMaintenanceTemplateJSONBody defines parameters for CreateMaintenanceTemplate.
+type CreateMaintenanceTemplateJSONBody CreateMaintenanceTemplatePayload
+
+// UpdateMaintenanceTemplateJSONBody defines parameters for UpdateMaintenanceTemplate.
+type UpdateMaintenanceTemplateJSONBody UpdateMaintenanceTemplatePayload
+
+// ListMaintenanceTemplatesParams defines parameters for ListMaintenanceTemplates.
+type ListMaintenanceTemplatesParams struct {
+	// Шаблоны работ в доме
+	BuildingId *string `json:"building_id,omitempty"`
+
+	// Только активные (true) или только приостановленные (false) шаблоны
+	Active     *bool       `json:"active,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Sort       *Sort       `json:"sort,omitempty"`
+}
+
+// ListMaintenanceTemplatesParamsSortSortOrder defines parameters for ListMaintenanceTemplates.
+type ListMaintenanceTemplatesParamsSortSortOrder string
+
+// GetPhotoParams defines parameters for GetPhoto.
+type GetPhotoParams struct {
+	Size *PhotoSize `json:"size,omitempty"`
+}
+
+// CreateVisitSlotJSONBody defines parameters for CreateVisitSlot.
+type CreateVisitSlotJSONBody CreateVisitSlotPayload
+
 // CreateUserJSONBody defines parameters for CreateUser.
 type CreateUserJSONBody CreateUserPayload
 
+// ListWorkerAbsencesParams defines parameters for ListWorkerAbsences.
+type ListWorkerAbsencesParams struct {
+	// Отсутствия, которые заканчиваются не раньше указанной даты
+	From *openapi_types.Date `json:"from,omitempty"`
+
+	// Отсутствия, которые начинаются не позже указанной даты
+	To         *openapi_types.Date `json:"to,omitempty"`
+	Pagination *Pagination         `json:"pagination,omitempty"`
+	Sort       *Sort               `json:"sort,omitempty"`
+}
+
+// ListWorkerAbsencesParamsSortSortOrder defines parameters for ListWorkerAbsences.
+type ListWorkerAbsencesParamsSortSortOrder string
+
+// CreateWorkerAbsenceJSONBody defines parameters for CreateWorkerAbsence.
+type CreateWorkerAbsenceJSONBody CreateWorkerAbsencePayload
+
+// SetWorkerQualificationsJSONBody defines parameters for SetWorkerQualifications.
+type SetWorkerQualificationsJSONBody SetWorkerQualificationsPayload
+
+// SetWorkerScheduleJSONBody defines parameters for SetWorkerSchedule.
+type SetWorkerScheduleJSONBody SetWorkerSchedulePayload
+
+// ListVisitSlotsParams defines parameters for ListVisitSlots.
+type ListVisitSlotsParams struct {
+	// Слоты, которые заканчиваются после указанного времени
+	From *time.Time `json:"from,omitempty"`
+
+	// Слоты, которые начинаются до указанного времени
+	To *time.Time `json:"to,omitempty"`
+
+	// Только свободные (true) или только забронированные (false) слоты
+	Available  *bool       `json:"available,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Sort       *Sort       `json:"sort,omitempty"`
+}
+
+// ListVisitSlotsParamsSortSortOrder defines parameters for ListVisitSlots.
+type ListVisitSlotsParamsSortSortOrder string
+
 // ListUsersParams defines parameters for ListUsers.
 type ListUsersParams struct {
-	// Идентификаторы хабов, по которым нужно получить статьи.
+	// Идентификаторы исполнителей, по которым нужно получить пользователей.
 	PerformerId *[]string `json:"performer_id,omitempty"`
 
-	// Идентификаторы авторов, по которым нужно получить статьи.
+	// Идентификаторы авторов, по которым нужно получить пользователей.
 	CreatorId *string `json:"creator_id,omitempty"`
 
-	// Получение пользоваеля по роли
+	// Получение пользователей по роли
 	Role *UserRole `json:"role,omitempty"`
 
-	// Получение статей по строке
+	// Поиск пользователей по строке
 	Search     *string     `json:"search,omitempty"`
 	Pagination *Pagination `json:"pagination,omitempty"`
 	Sort       *Sort       `json:"sort,omitempty"`
@@ -232,35 +1361,1000 @@ type ListUsersParams struct {
 // ListUsersParamsSortSortOrder defines parameters for ListUsers.
 type ListUsersParamsSortSortOrder string
 
+// UpdateApartmentJSONRequestBody defines body for UpdateApartment for application/json ContentType.
+type UpdateApartmentJSONRequestBody UpdateApartmentJSONBody
+
 // CreateApplicationJSONRequestBody defines body for CreateApplication for application/json ContentType.
 type CreateApplicationJSONRequestBody CreateApplicationJSONBody
 
 // UpdateApplicationJSONRequestBody defines body for UpdateApplication for application/json ContentType.
 type UpdateApplicationJSONRequestBody UpdateApplicationJSONBody
 
+// AssignApplicationPerformerJSONRequestBody defines body for AssignApplicationPerformer for application/json ContentType.
+type AssignApplicationPerformerJSONRequestBody AssignApplicationPerformerJSONBody
+
+// CancelApplicationJSONRequestBody defines body for CancelApplication for application/json ContentType.
+type CancelApplicationJSONRequestBody CancelApplicationJSONBody
+
+// SetApplicationChecklistItemJSONRequestBody defines body for SetApplicationChecklistItem for application/json ContentType.
+type SetApplicationChecklistItemJSONRequestBody SetApplicationChecklistItemJSONBody
+
+// CreateApplicationCommentJSONRequestBody defines body for CreateApplicationComment for application/json ContentType.
+type CreateApplicationCommentJSONRequestBody CreateApplicationCommentJSONBody
+
+// UpdateApplicationCommentJSONRequestBody defines body for UpdateApplicationComment for application/json ContentType.
+type UpdateApplicationCommentJSONRequestBody UpdateApplicationCommentJSONBody
+
+// DeclineApplicationAssignmentJSONRequestBody defines body for DeclineApplicationAssignment for application/json ContentType.
+type DeclineApplicationAssignmentJSONRequestBody DeclineApplicationAssignmentJSONBody
+
+// MarkApplicationDuplicateJSONRequestBody defines body for MarkApplicationDuplicate for application/json ContentType.
+type MarkApplicationDuplicateJSONRequestBody MarkApplicationDuplicateJSONBody
+
+// CreateApplicationLineItemJSONRequestBody defines body for CreateApplicationLineItem for application/json ContentType.
+type CreateApplicationLineItemJSONRequestBody CreateApplicationLineItemJSONBody
+
+// UpdateApplicationLineItemJSONRequestBody defines body for UpdateApplicationLineItem for application/json ContentType.
+type UpdateApplicationLineItemJSONRequestBody UpdateApplicationLineItemJSONBody
+
+// RateApplicationJSONRequestBody defines body for RateApplication for application/json ContentType.
+type RateApplicationJSONRequestBody RateApplicationJSONBody
+
+// ReopenApplicationJSONRequestBody defines body for ReopenApplication for application/json ContentType.
+type ReopenApplicationJSONRequestBody ReopenApplicationJSONBody
+
+// BookApplicationVisitSlotJSONRequestBody defines body for BookApplicationVisitSlot for application/json ContentType.
+type BookApplicationVisitSlotJSONRequestBody BookApplicationVisitSlotJSONBody
+
+// BulkUpdateApplicationsJSONRequestBody defines body for BulkUpdateApplications for application/json ContentType.
+type BulkUpdateApplicationsJSONRequestBody BulkUpdateApplicationsJSONBody
+
+// CreateApplicationSubTypeJSONRequestBody defines body for CreateApplicationSubType for application/json ContentType.
+type CreateApplicationSubTypeJSONRequestBody CreateApplicationSubTypeJSONBody
+
+// UpdateApplicationSubTypeJSONRequestBody defines body for UpdateApplicationSubType for application/json ContentType.
+type UpdateApplicationSubTypeJSONRequestBody UpdateApplicationSubTypeJSONBody
+
+// SetApplicationSubTypeArchivedJSONRequestBody defines body for SetApplicationSubTypeArchived for application/json ContentType.
+type SetApplicationSubTypeArchivedJSONRequestBody SetApplicationSubTypeArchivedJSONBody
+
+// SetSubTypeChecklistJSONRequestBody defines body for SetSubTypeChecklist for application/json ContentType.
+type SetSubTypeChecklistJSONRequestBody SetSubTypeChecklistJSONBody
+
+// CreateApplicationTypeJSONRequestBody defines body for CreateApplicationType for application/json ContentType.
+type CreateApplicationTypeJSONRequestBody CreateApplicationTypeJSONBody
+
+// ReorderApplicationTypesJSONRequestBody defines body for ReorderApplicationTypes for application/json ContentType.
+type ReorderApplicationTypesJSONRequestBody ReorderApplicationTypesJSONBody
+
+// UpdateApplicationTypeJSONRequestBody defines body for UpdateApplicationType for application/json ContentType.
+type UpdateApplicationTypeJSONRequestBody UpdateApplicationTypeJSONBody
+
+// SetApplicationTypeArchivedJSONRequestBody defines body for SetApplicationTypeArchived for application/json ContentType.
+type SetApplicationTypeArchivedJSONRequestBody SetApplicationTypeArchivedJSONBody
+
+// ReorderApplicationSubTypesJSONRequestBody defines body for ReorderApplicationSubTypes for application/json ContentType.
+type ReorderApplicationSubTypesJSONRequestBody ReorderApplicationSubTypesJSONBody
+
+// CreateBuildingJSONRequestBody defines body for CreateBuilding for application/json ContentType.
+type CreateBuildingJSONRequestBody CreateBuildingJSONBody
+
+// UpdateBuildingJSONRequestBody defines body for UpdateBuilding for application/json ContentType.
+type UpdateBuildingJSONRequestBody UpdateBuildingJSONBody
+
+// CreateApartmentJSONRequestBody defines body for CreateApartment for application/json ContentType.
+type CreateApartmentJSONRequestBody CreateApartmentJSONBody
+
+// CreateEntranceJSONRequestBody defines body for CreateEntrance for application/json ContentType.
+type CreateEntranceJSONRequestBody CreateEntranceJSONBody
+
+// UpdateEntranceJSONRequestBody defines body for UpdateEntrance for application/json ContentType.
+type UpdateEntranceJSONRequestBody UpdateEntranceJSONBody
+
+// CreateMaintenanceTemplateJSONRequestBody defines body for CreateMaintenanceTemplate for application/json ContentType.
+type CreateMaintenanceTemplateJSONRequestBody CreateMaintenanceTemplateJSONBody
+
+// UpdateMaintenanceTemplateJSONRequestBody defines body for UpdateMaintenanceTemplate for application/json ContentType.
+type UpdateMaintenanceTemplateJSONRequestBody UpdateMaintenanceTemplateJSONBody
+
+// CreateVisitSlotJSONRequestBody defines body for CreateVisitSlot for application/json ContentType.
+type CreateVisitSlotJSONRequestBody CreateVisitSlotJSONBody
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody CreateUserJSONBody
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Создание заявки.
-	// (POST /application)
-	CreateApplication(w http.ResponseWriter, r *http.Request)
-	// Получение заявки по идентификатору.
-	// (GET /application/{applicationId})
-	GetApplication(w http.ResponseWriter, r *http.Request, applicationId string)
-	// Редактирование заявки.
-	// (PATCH /application/{applicationId})
-	UpdateApplication(w http.ResponseWriter, r *http.Request, applicationId string)
-	// Получение списка статей.
-	// (GET /applications)
+// CreateWorkerAbsenceJSONRequestBody defines body for CreateWorkerAbsence for application/json ContentType.
+type CreateWorkerAbsenceJSONRequestBody CreateWorkerAbsenceJSONBody
+
+// SetWorkerQualificationsJSONRequestBody defines body for SetWorkerQualifications for application/json ContentType.
+type SetWorkerQualificationsJSONRequestBody SetWorkerQualificationsJSONBody
+
+// SetWorkerScheduleJSONRequestBody defines body for SetWorkerSchedule for application/json ContentType.
+type SetWorkerScheduleJSONRequestBody SetWorkerScheduleJSONBody
+
+// Getter for additional properties for ApplicationResponse_Fields. Returns the specified
+// element and whether it was found
+func (a ApplicationResponse_Fields) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ApplicationResponse_Fields
+func (a *ApplicationResponse_Fields) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ApplicationResponse_Fields to handle AdditionalProperties
+func (a *ApplicationResponse_Fields) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ApplicationResponse_Fields to handle AdditionalProperties
+func (a ApplicationResponse_Fields) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for ApplicationSubtype_Titles. Returns the specified
+// element and whether it was found
+func (a ApplicationSubtype_Titles) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ApplicationSubtype_Titles
+func (a *ApplicationSubtype_Titles) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ApplicationSubtype_Titles to handle AdditionalProperties
+func (a *ApplicationSubtype_Titles) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ApplicationSubtype_Titles to handle AdditionalProperties
+func (a ApplicationSubtype_Titles) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for ApplicationType_FieldsSchema. Returns the specified
+// element and whether it was found
+func (a ApplicationType_FieldsSchema) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ApplicationType_FieldsSchema
+func (a *ApplicationType_FieldsSchema) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ApplicationType_FieldsSchema to handle AdditionalProperties
+func (a *ApplicationType_FieldsSchema) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ApplicationType_FieldsSchema to handle AdditionalProperties
+func (a ApplicationType_FieldsSchema) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for ApplicationType_Titles. Returns the specified
+// element and whether it was found
+func (a ApplicationType_Titles) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ApplicationType_Titles
+func (a *ApplicationType_Titles) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ApplicationType_Titles to handle AdditionalProperties
+func (a *ApplicationType_Titles) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ApplicationType_Titles to handle AdditionalProperties
+func (a ApplicationType_Titles) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for BulkApplicationFilter_Fields. Returns the specified
+// element and whether it was found
+func (a BulkApplicationFilter_Fields) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for BulkApplicationFilter_Fields
+func (a *BulkApplicationFilter_Fields) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for BulkApplicationFilter_Fields to handle AdditionalProperties
+func (a *BulkApplicationFilter_Fields) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for BulkApplicationFilter_Fields to handle AdditionalProperties
+func (a BulkApplicationFilter_Fields) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for CreateApplicationPayload_Fields. Returns the specified
+// element and whether it was found
+func (a CreateApplicationPayload_Fields) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CreateApplicationPayload_Fields
+func (a *CreateApplicationPayload_Fields) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CreateApplicationPayload_Fields to handle AdditionalProperties
+func (a *CreateApplicationPayload_Fields) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CreateApplicationPayload_Fields to handle AdditionalProperties
+func (a CreateApplicationPayload_Fields) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for CreateApplicationSubTypePayload_Titles. Returns the specified
+// element and whether it was found
+func (a CreateApplicationSubTypePayload_Titles) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CreateApplicationSubTypePayload_Titles
+func (a *CreateApplicationSubTypePayload_Titles) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CreateApplicationSubTypePayload_Titles to handle AdditionalProperties
+func (a *CreateApplicationSubTypePayload_Titles) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CreateApplicationSubTypePayload_Titles to handle AdditionalProperties
+func (a CreateApplicationSubTypePayload_Titles) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for CreateApplicationTypePayload_FieldsSchema. Returns the specified
+// element and whether it was found
+func (a CreateApplicationTypePayload_FieldsSchema) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CreateApplicationTypePayload_FieldsSchema
+func (a *CreateApplicationTypePayload_FieldsSchema) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CreateApplicationTypePayload_FieldsSchema to handle AdditionalProperties
+func (a *CreateApplicationTypePayload_FieldsSchema) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CreateApplicationTypePayload_FieldsSchema to handle AdditionalProperties
+func (a CreateApplicationTypePayload_FieldsSchema) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for CreateApplicationTypePayload_Titles. Returns the specified
+// element and whether it was found
+func (a CreateApplicationTypePayload_Titles) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CreateApplicationTypePayload_Titles
+func (a *CreateApplicationTypePayload_Titles) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CreateApplicationTypePayload_Titles to handle AdditionalProperties
+func (a *CreateApplicationTypePayload_Titles) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CreateApplicationTypePayload_Titles to handle AdditionalProperties
+func (a CreateApplicationTypePayload_Titles) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateApplicationPayload_Fields. Returns the specified
+// element and whether it was found
+func (a UpdateApplicationPayload_Fields) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateApplicationPayload_Fields
+func (a *UpdateApplicationPayload_Fields) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateApplicationPayload_Fields to handle AdditionalProperties
+func (a *UpdateApplicationPayload_Fields) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateApplicationPayload_Fields to handle AdditionalProperties
+func (a UpdateApplicationPayload_Fields) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateApplicationSubTypePayload_Titles. Returns the specified
+// element and whether it was found
+func (a UpdateApplicationSubTypePayload_Titles) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateApplicationSubTypePayload_Titles
+func (a *UpdateApplicationSubTypePayload_Titles) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateApplicationSubTypePayload_Titles to handle AdditionalProperties
+func (a *UpdateApplicationSubTypePayload_Titles) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateApplicationSubTypePayload_Titles to handle AdditionalProperties
+func (a UpdateApplicationSubTypePayload_Titles) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateApplicationTypePayload_FieldsSchema. Returns the specified
+// element and whether it was found
+func (a UpdateApplicationTypePayload_FieldsSchema) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateApplicationTypePayload_FieldsSchema
+func (a *UpdateApplicationTypePayload_FieldsSchema) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateApplicationTypePayload_FieldsSchema to handle AdditionalProperties
+func (a *UpdateApplicationTypePayload_FieldsSchema) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateApplicationTypePayload_FieldsSchema to handle AdditionalProperties
+func (a UpdateApplicationTypePayload_FieldsSchema) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateApplicationTypePayload_Titles. Returns the specified
+// element and whether it was found
+func (a UpdateApplicationTypePayload_Titles) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateApplicationTypePayload_Titles
+func (a *UpdateApplicationTypePayload_Titles) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateApplicationTypePayload_Titles to handle AdditionalProperties
+func (a *UpdateApplicationTypePayload_Titles) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateApplicationTypePayload_Titles to handle AdditionalProperties
+func (a UpdateApplicationTypePayload_Titles) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Удаление отсутствия исполнителем или модератором.
+	// (DELETE /absence/{absenceId})
+	DeleteWorkerAbsence(w http.ResponseWriter, r *http.Request, absenceId string)
+	// Удаление квартиры.
+	// (DELETE /apartment/{apartmentId})
+	DeleteApartment(w http.ResponseWriter, r *http.Request, apartmentId string)
+	// Получение квартиры.
+	// (GET /apartment/{apartmentId})
+	GetApartment(w http.ResponseWriter, r *http.Request, apartmentId string)
+	// Редактирование квартиры модератором.
+	// (PATCH /apartment/{apartmentId})
+	UpdateApartment(w http.ResponseWriter, r *http.Request, apartmentId string)
+	// Создание заявки.
+	// (POST /application)
+	CreateApplication(w http.ResponseWriter, r *http.Request)
+	// Получение заявки по идентификатору.
+	// (GET /application/{applicationId})
+	GetApplication(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Редактирование заявки.
+	// (PATCH /application/{applicationId})
+	UpdateApplication(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Принятие заявки в работу назначенным исполнителем.
+	// (POST /application/{applicationId}/accept)
+	AcceptApplicationAssignment(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Получение акта выполненных работ по заявке.
+	// (GET /application/{applicationId}/act)
+	GetApplicationAct(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Назначение исполнителя заявки модератором.
+	// (POST /application/{applicationId}/assign)
+	AssignApplicationPerformer(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Отзыв заявки автором до её выполнения.
+	// (POST /application/{applicationId}/cancel)
+	CancelApplication(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Получение чек-листа заявки.
+	// (GET /application/{applicationId}/checklist)
+	ListApplicationChecklist(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Отметка о выполнении шага чек-листа исполнителем заявки в работе.
+	// (PUT /application/{applicationId}/checklist/{itemId})
+	SetApplicationChecklistItem(w http.ResponseWriter, r *http.Request, applicationId string, itemId string)
+	// Получение комментариев к заявке.
+	// (GET /application/{applicationId}/comments)
+	ListApplicationComments(w http.ResponseWriter, r *http.Request, applicationId string, params ListApplicationCommentsParams)
+	// Создание комментария к заявке.
+	// (POST /application/{applicationId}/comments)
+	CreateApplicationComment(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Удаление комментария к заявке.
+	// (DELETE /application/{applicationId}/comments/{commentId})
+	DeleteApplicationComment(w http.ResponseWriter, r *http.Request, applicationId string, commentId string)
+	// Редактирование комментария к заявке.
+	// (PATCH /application/{applicationId}/comments/{commentId})
+	UpdateApplicationComment(w http.ResponseWriter, r *http.Request, applicationId string, commentId string)
+	// Отказ назначенного исполнителя от заявки.
+	// (POST /application/{applicationId}/decline)
+	DeclineApplicationAssignment(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Закрытие заявки модератором как дубликата другой заявки.
+	// (POST /application/{applicationId}/duplicate)
+	MarkApplicationDuplicate(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Получение истории изменений заявки.
+	// (GET /application/{applicationId}/history)
+	ListApplicationHistory(w http.ResponseWriter, r *http.Request, applicationId string, params ListApplicationHistoryParams)
+	// Получение материалов и трудозатрат по заявке.
+	// (GET /application/{applicationId}/items)
+	ListApplicationLineItems(w http.ResponseWriter, r *http.Request, applicationId string, params ListApplicationLineItemsParams)
+	// Добавление материала или трудозатрат исполнителем заявки в работе.
+	// (POST /application/{applicationId}/items)
+	CreateApplicationLineItem(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Удаление материала или трудозатрат исполнителем заявки в работе.
+	// (DELETE /application/{applicationId}/items/{itemId})
+	DeleteApplicationLineItem(w http.ResponseWriter, r *http.Request, applicationId string, itemId string)
+	// Изменение материала или трудозатрат исполнителем заявки в работе.
+	// (PATCH /application/{applicationId}/items/{itemId})
+	UpdateApplicationLineItem(w http.ResponseWriter, r *http.Request, applicationId string, itemId string)
+	// Оценка выполненной заявки её создателем.
+	// (POST /application/{applicationId}/rating)
+	RateApplication(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Повторное открытие выполненной заявки автором или модератором.
+	// (POST /application/{applicationId}/reopen)
+	ReopenApplication(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Бронирование автором заявки свободного слота исполнителя.
+	// (POST /application/{applicationId}/slot)
+	BookApplicationVisitSlot(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Снятие исполнителя с заявки модератором.
+	// (POST /application/{applicationId}/unassign)
+	UnassignApplicationPerformer(w http.ResponseWriter, r *http.Request, applicationId string)
+	// Получение списка заявок.
+	// (GET /applications)
 	ListApplications(w http.ResponseWriter, r *http.Request, params ListApplicationsParams)
+	// Массовое изменение статуса, исполнителя или приоритета заявок. Только для модераторов.
+	// (POST /applications/bulk)
+	BulkUpdateApplications(w http.ResponseWriter, r *http.Request)
+	// Получение стоимости материалов и трудозатрат по типам заявок. Доступно только модераторам.
+	// (GET /applications/costs)
+	GetApplicationCosts(w http.ResponseWriter, r *http.Request)
+	// Получение средних оценок по исполнителям и типам заявок.
+	// (GET /applications/ratings)
+	GetApplicationRatings(w http.ResponseWriter, r *http.Request)
 	// Получение списка подтипов заявок.
 	// (GET /applications/subtypes)
 	ListApplicationSubTypes(w http.ResponseWriter, r *http.Request, params ListApplicationSubTypesParams)
+	// Создание подтипа заявок модератором.
+	// (POST /applications/subtypes)
+	CreateApplicationSubType(w http.ResponseWriter, r *http.Request)
+	// Редактирование подтипа заявок модератором.
+	// (PATCH /applications/subtypes/{subtypeId})
+	UpdateApplicationSubType(w http.ResponseWriter, r *http.Request, subtypeId string)
+	// Перенос подтипа заявок в архив или возврат из архива модератором. Архивный подтип недоступен для новых заявок.
+	// (PUT /applications/subtypes/{subtypeId}/archived)
+	SetApplicationSubTypeArchived(w http.ResponseWriter, r *http.Request, subtypeId string)
+	// Получение чек-листа подтипа заявок.
+	// (GET /applications/subtypes/{subtypeId}/checklist)
+	GetSubTypeChecklist(w http.ResponseWriter, r *http.Request, subtypeId string)
+	// Замена чек-листа подтипа заявок модератором.
+	// (PUT /applications/subtypes/{subtypeId}/checklist)
+	SetSubTypeChecklist(w http.ResponseWriter, r *http.Request, subtypeId string)
 	// Получение списка типов заявок.
 	// (GET /applications/types)
 	ListApplicationTypes(w http.ResponseWriter, r *http.Request, params ListApplicationTypesParams)
+	// Создание типа заявок модератором.
+	// (POST /applications/types)
+	CreateApplicationType(w http.ResponseWriter, r *http.Request)
+	// Изменение порядка типов заявок модератором. Список должен содержать все типы.
+	// (PUT /applications/types/order)
+	ReorderApplicationTypes(w http.ResponseWriter, r *http.Request)
+	// Редактирование типа заявок модератором.
+	// (PATCH /applications/types/{typeId})
+	UpdateApplicationType(w http.ResponseWriter, r *http.Request, typeId string)
+	// Перенос типа заявок в архив или возврат из архива модератором. Архивный тип недоступен для новых заявок.
+	// (PUT /applications/types/{typeId}/archived)
+	SetApplicationTypeArchived(w http.ResponseWriter, r *http.Request, typeId string)
+	// Изменение порядка подтипов типа заявок модератором. Список должен содержать все подтипы типа.
+	// (PUT /applications/types/{typeId}/subtypes/order)
+	ReorderApplicationSubTypes(w http.ResponseWriter, r *http.Request, typeId string)
+	// Создание дома модератором.
+	// (POST /building)
+	CreateBuilding(w http.ResponseWriter, r *http.Request)
+	// Удаление дома вместе с подъездами и квартирами.
+	// (DELETE /building/{buildingId})
+	DeleteBuilding(w http.ResponseWriter, r *http.Request, buildingId string)
+	// Получение дома.
+	// (GET /building/{buildingId})
+	GetBuilding(w http.ResponseWriter, r *http.Request, buildingId string)
+	// Редактирование дома модератором.
+	// (PATCH /building/{buildingId})
+	UpdateBuilding(w http.ResponseWriter, r *http.Request, buildingId string)
+	// Получение списка квартир дома.
+	// (GET /building/{buildingId}/apartments)
+	ListApartments(w http.ResponseWriter, r *http.Request, buildingId string, params ListApartmentsParams)
+	// Создание квартиры модератором.
+	// (POST /building/{buildingId}/apartments)
+	CreateApartment(w http.ResponseWriter, r *http.Request, buildingId string)
+	// Получение списка подъездов дома.
+	// (GET /building/{buildingId}/entrances)
+	ListEntrances(w http.ResponseWriter, r *http.Request, buildingId string, params ListEntrancesParams)
+	// Создание подъезда модератором.
+	// (POST /building/{buildingId}/entrances)
+	CreateEntrance(w http.ResponseWriter, r *http.Request, buildingId string)
+	// Получение списка домов.
+	// (GET /buildings)
+	ListBuildings(w http.ResponseWriter, r *http.Request, params ListBuildingsParams)
+	// Удаление подъезда, квартиры остаются в доме без подъезда.
+	// (DELETE /entrance/{entranceId})
+	DeleteEntrance(w http.ResponseWriter, r *http.Request, entranceId string)
+	// Редактирование подъезда модератором.
+	// (PATCH /entrance/{entranceId})
+	UpdateEntrance(w http.ResponseWriter, r *http.Request, entranceId string)
+	// Создание шаблона плановых работ модератором.
+	// (POST /maintenance)
+	CreateMaintenanceTemplate(w http.ResponseWriter, r *http.Request)
+	// Удаление шаблона плановых работ, созданные заявки остаются.
+	// (DELETE /maintenance/{templateId})
+	DeleteMaintenanceTemplate(w http.ResponseWriter, r *http.Request, templateId string)
+	// Получение шаблона плановых работ.
+	// (GET /maintenance/{templateId})
+	GetMaintenanceTemplate(w http.ResponseWriter, r *http.Request, templateId string)
+	// Изменение шаблона плановых работ, следующий запуск планируется заново.
+	// (PATCH /maintenance/{templateId})
+	UpdateMaintenanceTemplate(w http.ResponseWriter, r *http.Request, templateId string)
+	// Получение шаблонов плановых работ.
+	// (GET /maintenances)
+	ListMaintenanceTemplates(w http.ResponseWriter, r *http.Request, params ListMaintenanceTemplatesParams)
+	// Загрузка фотографии.
+	// (POST /photo)
+	UploadPhoto(w http.ResponseWriter, r *http.Request)
+	// Получение фотографии по идентификатору.
+	// (GET /photo/{photoId})
+	GetPhoto(w http.ResponseWriter, r *http.Request, photoId string, params GetPhotoParams)
+	// Публикация исполнителем времени, доступного для визита.
+	// (POST /slot)
+	CreateVisitSlot(w http.ResponseWriter, r *http.Request)
+	// Удаление свободного слота исполнителем или модератором.
+	// (DELETE /slot/{slotId})
+	DeleteVisitSlot(w http.ResponseWriter, r *http.Request, slotId string)
 	// Создание пользователя.
 	// (POST /user)
 	CreateUser(w http.ResponseWriter, r *http.Request)
@@ -270,26 +2364,1829 @@ type ServerInterface interface {
 	// Получение пользователя по идентификатору.
 	// (GET /user/{userId})
 	GetUser(w http.ResponseWriter, r *http.Request, userId string)
+	// Получение отсутствий исполнителя.
+	// (GET /user/{userId}/absences)
+	ListWorkerAbsences(w http.ResponseWriter, r *http.Request, userId string, params ListWorkerAbsencesParams)
+	// Добавление выходного, отпуска или больничного исполнителя.
+	// (POST /user/{userId}/absences)
+	CreateWorkerAbsence(w http.ResponseWriter, r *http.Request, userId string)
+	// Получение квалификаций исполнителя для автоматического назначения. Только для модераторов.
+	// (GET /user/{userId}/qualifications)
+	GetWorkerQualifications(w http.ResponseWriter, r *http.Request, userId string)
+	// Замена квалификаций исполнителя модератором.
+	// (PUT /user/{userId}/qualifications)
+	SetWorkerQualifications(w http.ResponseWriter, r *http.Request, userId string)
+	// Получение рабочих часов исполнителя.
+	// (GET /user/{userId}/schedule)
+	GetWorkerSchedule(w http.ResponseWriter, r *http.Request, userId string)
+	// Замена рабочих часов исполнителя исполнителем или модератором.
+	// (PUT /user/{userId}/schedule)
+	SetWorkerSchedule(w http.ResponseWriter, r *http.Request, userId string)
+	// Получение слотов исполнителя.
+	// (GET /user/{userId}/slots)
+	ListVisitSlots(w http.ResponseWriter, r *http.Request, userId string, params ListVisitSlotsParams)
 	// Получение списка пользователей.
 	// (GET /users)
 	ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+
+// DeleteWorkerAbsence operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkerAbsence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "absenceId" -------------
+	var absenceId string
+
+	err = runtime.BindStyledParameter("simple", false, "absenceId", chi.URLParam(r, "absenceId"), &absenceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "absenceId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWorkerAbsence(w, r, absenceId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteApartment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApartment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "apartmentId" -------------
+	var apartmentId string
+
+	err = runtime.BindStyledParameter("simple", false, "apartmentId", chi.URLParam(r, "apartmentId"), &apartmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apartmentId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApartment(w, r, apartmentId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetApartment operation middleware
+func (siw *ServerInterfaceWrapper) GetApartment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "apartmentId" -------------
+	var apartmentId string
+
+	err = runtime.BindStyledParameter("simple", false, "apartmentId", chi.URLParam(r, "apartmentId"), &apartmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apartmentId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApartment(w, r, apartmentId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateApartment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApartment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "apartmentId" -------------
+	var apartmentId string
+
+	err = runtime.BindStyledParameter("simple", false, "apartmentId", chi.URLParam(r, "apartmentId"), &apartmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apartmentId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateApartment(w, r, apartmentId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateApplication operation middleware
+func (siw *ServerInterfaceWrapper) CreateApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApplication(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetApplication operation middleware
+func (siw *ServerInterfaceWrapper) GetApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApplication(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateApplication operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateApplication(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AcceptApplicationAssignment operation middleware
+func (siw *ServerInterfaceWrapper) AcceptApplicationAssignment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptApplicationAssignment(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetApplicationAct operation middleware
+func (siw *ServerInterfaceWrapper) GetApplicationAct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApplicationAct(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AssignApplicationPerformer operation middleware
+func (siw *ServerInterfaceWrapper) AssignApplicationPerformer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AssignApplicationPerformer(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CancelApplication operation middleware
+func (siw *ServerInterfaceWrapper) CancelApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelApplication(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListApplicationChecklist operation middleware
+func (siw *ServerInterfaceWrapper) ListApplicationChecklist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplicationChecklist(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SetApplicationChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) SetApplicationChecklistItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId string
+
+	err = runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetApplicationChecklistItem(w, r, applicationId, itemId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListApplicationComments operation middleware
+func (siw *ServerInterfaceWrapper) ListApplicationComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApplicationCommentsParams
+
+	// ------------- Optional query parameter "pagination" -------------
+	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplicationComments(w, r, applicationId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateApplicationComment operation middleware
+func (siw *ServerInterfaceWrapper) CreateApplicationComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApplicationComment(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteApplicationComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApplicationComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId string
+
+	err = runtime.BindStyledParameter("simple", false, "commentId", chi.URLParam(r, "commentId"), &commentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApplicationComment(w, r, applicationId, commentId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateApplicationComment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApplicationComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId string
+
+	err = runtime.BindStyledParameter("simple", false, "commentId", chi.URLParam(r, "commentId"), &commentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateApplicationComment(w, r, applicationId, commentId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeclineApplicationAssignment operation middleware
+func (siw *ServerInterfaceWrapper) DeclineApplicationAssignment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeclineApplicationAssignment(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// MarkApplicationDuplicate operation middleware
+func (siw *ServerInterfaceWrapper) MarkApplicationDuplicate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MarkApplicationDuplicate(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListApplicationHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListApplicationHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApplicationHistoryParams
+
+	// ------------- Optional query parameter "pagination" -------------
+	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplicationHistory(w, r, applicationId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListApplicationLineItems operation middleware
+func (siw *ServerInterfaceWrapper) ListApplicationLineItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApplicationLineItemsParams
+
+	// ------------- Optional query parameter "pagination" -------------
+	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplicationLineItems(w, r, applicationId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateApplicationLineItem operation middleware
+func (siw *ServerInterfaceWrapper) CreateApplicationLineItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApplicationLineItem(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteApplicationLineItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApplicationLineItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId string
+
+	err = runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApplicationLineItem(w, r, applicationId, itemId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateApplicationLineItem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApplicationLineItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId string
+
+	err = runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateApplicationLineItem(w, r, applicationId, itemId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RateApplication operation middleware
+func (siw *ServerInterfaceWrapper) RateApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RateApplication(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ReopenApplication operation middleware
+func (siw *ServerInterfaceWrapper) ReopenApplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReopenApplication(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// BookApplicationVisitSlot operation middleware
+func (siw *ServerInterfaceWrapper) BookApplicationVisitSlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BookApplicationVisitSlot(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UnassignApplicationPerformer operation middleware
+func (siw *ServerInterfaceWrapper) UnassignApplicationPerformer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId string
+
+	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnassignApplicationPerformer(w, r, applicationId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListApplications operation middleware
+func (siw *ServerInterfaceWrapper) ListApplications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApplicationsParams
+
+	// ------------- Optional query parameter "performer_id" -------------
+	if paramValue := r.URL.Query().Get("performer_id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "performer_id", r.URL.Query(), &params.PerformerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "performer_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "creator_id" -------------
+	if paramValue := r.URL.Query().Get("creator_id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "creator_id", r.URL.Query(), &params.CreatorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "creator_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+	if paramValue := r.URL.Query().Get("priority"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "overdue" -------------
+	if paramValue := r.URL.Query().Get("overdue"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", r.URL.Query(), &params.Overdue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "overdue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "building_id" -------------
+	if paramValue := r.URL.Query().Get("building_id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "building_id", r.URL.Query(), &params.BuildingId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "building_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "apartment_id" -------------
+	if paramValue := r.URL.Query().Get("apartment_id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "apartment_id", r.URL.Query(), &params.ApartmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apartment_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "recurrence_id" -------------
+	if paramValue := r.URL.Query().Get("recurrence_id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "recurrence_id", r.URL.Query(), &params.RecurrenceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurrence_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+	if paramValue := r.URL.Query().Get("fields"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "bbox" -------------
+	if paramValue := r.URL.Query().Get("bbox"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", false, false, "bbox", r.URL.Query(), &params.Bbox)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bbox", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lat" -------------
+	if paramValue := r.URL.Query().Get("lat"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "lat", r.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lon" -------------
+	if paramValue := r.URL.Query().Get("lon"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "lon", r.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "radius" -------------
+	if paramValue := r.URL.Query().Get("radius"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "radius", r.URL.Query(), &params.Radius)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "radius", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pagination" -------------
+	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplications(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// BulkUpdateApplications operation middleware
+func (siw *ServerInterfaceWrapper) BulkUpdateApplications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkUpdateApplications(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetApplicationCosts operation middleware
+func (siw *ServerInterfaceWrapper) GetApplicationCosts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApplicationCosts(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetApplicationRatings operation middleware
+func (siw *ServerInterfaceWrapper) GetApplicationRatings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApplicationRatings(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListApplicationSubTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListApplicationSubTypes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApplicationSubTypesParams
+
+	// ------------- Optional query parameter "typeId" -------------
+	if paramValue := r.URL.Query().Get("typeId"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "typeId", r.URL.Query(), &params.TypeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+	if paramValue := r.URL.Query().Get("search"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_archived" -------------
+	if paramValue := r.URL.Query().Get("include_archived"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pagination" -------------
+	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept-Language", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Accept-Language", runtime.ParamLocationHeader, valueList[0], &AcceptLanguage)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept-Language", Err: err})
+			return
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplicationSubTypes(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateApplicationSubType operation middleware
+func (siw *ServerInterfaceWrapper) CreateApplicationSubType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApplicationSubType(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateApplicationSubType operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApplicationSubType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "subtypeId" -------------
+	var subtypeId string
+
+	err = runtime.BindStyledParameter("simple", false, "subtypeId", chi.URLParam(r, "subtypeId"), &subtypeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subtypeId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateApplicationSubType(w, r, subtypeId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SetApplicationSubTypeArchived operation middleware
+func (siw *ServerInterfaceWrapper) SetApplicationSubTypeArchived(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "subtypeId" -------------
+	var subtypeId string
+
+	err = runtime.BindStyledParameter("simple", false, "subtypeId", chi.URLParam(r, "subtypeId"), &subtypeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subtypeId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetApplicationSubTypeArchived(w, r, subtypeId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetSubTypeChecklist operation middleware
+func (siw *ServerInterfaceWrapper) GetSubTypeChecklist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "subtypeId" -------------
+	var subtypeId string
+
+	err = runtime.BindStyledParameter("simple", false, "subtypeId", chi.URLParam(r, "subtypeId"), &subtypeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subtypeId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubTypeChecklist(w, r, subtypeId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SetSubTypeChecklist operation middleware
+func (siw *ServerInterfaceWrapper) SetSubTypeChecklist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "subtypeId" -------------
+	var subtypeId string
+
+	err = runtime.BindStyledParameter("simple", false, "subtypeId", chi.URLParam(r, "subtypeId"), &subtypeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subtypeId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSubTypeChecklist(w, r, subtypeId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListApplicationTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListApplicationTypes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApplicationTypesParams
+
+	// ------------- Optional query parameter "search" -------------
+	if paramValue := r.URL.Query().Get("search"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_archived" -------------
+	if paramValue := r.URL.Query().Get("include_archived"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pagination" -------------
+	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept-Language", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Accept-Language", runtime.ParamLocationHeader, valueList[0], &AcceptLanguage)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept-Language", Err: err})
+			return
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApplicationTypes(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateApplicationType operation middleware
+func (siw *ServerInterfaceWrapper) CreateApplicationType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApplicationType(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ReorderApplicationTypes operation middleware
+func (siw *ServerInterfaceWrapper) ReorderApplicationTypes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderApplicationTypes(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateApplicationType operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApplicationType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "typeId" -------------
+	var typeId string
+
+	err = runtime.BindStyledParameter("simple", false, "typeId", chi.URLParam(r, "typeId"), &typeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateApplicationType(w, r, typeId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SetApplicationTypeArchived operation middleware
+func (siw *ServerInterfaceWrapper) SetApplicationTypeArchived(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "typeId" -------------
+	var typeId string
+
+	err = runtime.BindStyledParameter("simple", false, "typeId", chi.URLParam(r, "typeId"), &typeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetApplicationTypeArchived(w, r, typeId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ReorderApplicationSubTypes operation middleware
+func (siw *ServerInterfaceWrapper) ReorderApplicationSubTypes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "typeId" -------------
+	var typeId string
+
+	err = runtime.BindStyledParameter("simple", false, "typeId", chi.URLParam(r, "typeId"), &typeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderApplicationSubTypes(w, r, typeId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateBuilding operation middleware
+func (siw *ServerInterfaceWrapper) CreateBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBuilding(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteBuilding operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "buildingId" -------------
+	var buildingId string
+
+	err = runtime.BindStyledParameter("simple", false, "buildingId", chi.URLParam(r, "buildingId"), &buildingId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "buildingId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBuilding(w, r, buildingId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBuilding operation middleware
+func (siw *ServerInterfaceWrapper) GetBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "buildingId" -------------
+	var buildingId string
+
+	err = runtime.BindStyledParameter("simple", false, "buildingId", chi.URLParam(r, "buildingId"), &buildingId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "buildingId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBuilding(w, r, buildingId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateBuilding operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "buildingId" -------------
+	var buildingId string
+
+	err = runtime.BindStyledParameter("simple", false, "buildingId", chi.URLParam(r, "buildingId"), &buildingId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "buildingId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBuilding(w, r, buildingId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListApartments operation middleware
+func (siw *ServerInterfaceWrapper) ListApartments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "buildingId" -------------
+	var buildingId string
+
+	err = runtime.BindStyledParameter("simple", false, "buildingId", chi.URLParam(r, "buildingId"), &buildingId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "buildingId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApartmentsParams
+
+	// ------------- Optional query parameter "entrance_id" -------------
+	if paramValue := r.URL.Query().Get("entrance_id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "entrance_id", r.URL.Query(), &params.EntranceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entrance_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pagination" -------------
+	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListApartments(w, r, buildingId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateApartment operation middleware
+func (siw *ServerInterfaceWrapper) CreateApartment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "buildingId" -------------
+	var buildingId string
+
+	err = runtime.BindStyledParameter("simple", false, "buildingId", chi.URLParam(r, "buildingId"), &buildingId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "buildingId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApartment(w, r, buildingId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListEntrances operation middleware
+func (siw *ServerInterfaceWrapper) ListEntrances(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "buildingId" -------------
+	var buildingId string
+
+	err = runtime.BindStyledParameter("simple", false, "buildingId", chi.URLParam(r, "buildingId"), &buildingId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "buildingId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEntrancesParams
+
+	// ------------- Optional query parameter "pagination" -------------
+	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEntrances(w, r, buildingId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateEntrance operation middleware
+func (siw *ServerInterfaceWrapper) CreateEntrance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "buildingId" -------------
+	var buildingId string
+
+	err = runtime.BindStyledParameter("simple", false, "buildingId", chi.URLParam(r, "buildingId"), &buildingId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "buildingId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEntrance(w, r, buildingId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
 }
 
-type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+// ListBuildings operation middleware
+func (siw *ServerInterfaceWrapper) ListBuildings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBuildingsParams
+
+	// ------------- Optional query parameter "pagination" -------------
+	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
 
-// CreateApplication operation middleware
-func (siw *ServerInterfaceWrapper) CreateApplication(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateApplication(w, r)
+		siw.Handler.ListBuildings(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -299,23 +4196,23 @@ func (siw *ServerInterfaceWrapper) CreateApplication(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// GetApplication operation middleware
-func (siw *ServerInterfaceWrapper) GetApplication(w http.ResponseWriter, r *http.Request) {
+// DeleteEntrance operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEntrance(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "applicationId" -------------
-	var applicationId string
+	// ------------- Path parameter "entranceId" -------------
+	var entranceId string
 
-	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	err = runtime.BindStyledParameter("simple", false, "entranceId", chi.URLParam(r, "entranceId"), &entranceId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entranceId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApplication(w, r, applicationId)
+		siw.Handler.DeleteEntrance(w, r, entranceId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -325,23 +4222,23 @@ func (siw *ServerInterfaceWrapper) GetApplication(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// UpdateApplication operation middleware
-func (siw *ServerInterfaceWrapper) UpdateApplication(w http.ResponseWriter, r *http.Request) {
+// UpdateEntrance operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEntrance(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "applicationId" -------------
-	var applicationId string
+	// ------------- Path parameter "entranceId" -------------
+	var entranceId string
 
-	err = runtime.BindStyledParameter("simple", false, "applicationId", chi.URLParam(r, "applicationId"), &applicationId)
+	err = runtime.BindStyledParameter("simple", false, "entranceId", chi.URLParam(r, "entranceId"), &entranceId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entranceId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateApplication(w, r, applicationId)
+		siw.Handler.UpdateEntrance(w, r, entranceId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -351,56 +4248,127 @@ func (siw *ServerInterfaceWrapper) UpdateApplication(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// ListApplications operation middleware
-func (siw *ServerInterfaceWrapper) ListApplications(w http.ResponseWriter, r *http.Request) {
+// CreateMaintenanceTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateMaintenanceTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMaintenanceTemplate(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteMaintenanceTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMaintenanceTemplate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListApplicationsParams
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
 
-	// ------------- Optional query parameter "performer_id" -------------
-	if paramValue := r.URL.Query().Get("performer_id"); paramValue != "" {
+	err = runtime.BindStyledParameter("simple", false, "templateId", chi.URLParam(r, "templateId"), &templateId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
+		return
+	}
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMaintenanceTemplate(w, r, templateId)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "performer_id", r.URL.Query(), &params.PerformerId)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetMaintenanceTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetMaintenanceTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameter("simple", false, "templateId", chi.URLParam(r, "templateId"), &templateId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "performer_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "creator_id" -------------
-	if paramValue := r.URL.Query().Get("creator_id"); paramValue != "" {
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMaintenanceTemplate(w, r, templateId)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "creator_id", r.URL.Query(), &params.CreatorId)
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateMaintenanceTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMaintenanceTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "templateId" -------------
+	var templateId string
+
+	err = runtime.BindStyledParameter("simple", false, "templateId", chi.URLParam(r, "templateId"), &templateId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "creator_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
-	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMaintenanceTemplate(w, r, templateId)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	handler(w, r.WithContext(ctx))
+}
+
+// ListMaintenanceTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListMaintenanceTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMaintenanceTemplatesParams
+
+	// ------------- Optional query parameter "building_id" -------------
+	if paramValue := r.URL.Query().Get("building_id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "building_id", r.URL.Query(), &params.BuildingId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "building_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "type" -------------
-	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+	// ------------- Optional query parameter "active" -------------
+	if paramValue := r.URL.Query().Get("active"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
 		return
 	}
 
@@ -427,7 +4395,7 @@ func (siw *ServerInterfaceWrapper) ListApplications(w http.ResponseWriter, r *ht
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListApplications(w, r, params)
+		siw.Handler.ListMaintenanceTemplates(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -437,34 +4405,206 @@ func (siw *ServerInterfaceWrapper) ListApplications(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// ListApplicationSubTypes operation middleware
-func (siw *ServerInterfaceWrapper) ListApplicationSubTypes(w http.ResponseWriter, r *http.Request) {
+// UploadPhoto operation middleware
+func (siw *ServerInterfaceWrapper) UploadPhoto(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadPhoto(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPhoto operation middleware
+func (siw *ServerInterfaceWrapper) GetPhoto(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "photoId" -------------
+	var photoId string
+
+	err = runtime.BindStyledParameter("simple", false, "photoId", chi.URLParam(r, "photoId"), &photoId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "photoId", Err: err})
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListApplicationSubTypesParams
+	var params GetPhotoParams
 
-	// ------------- Optional query parameter "typeId" -------------
-	if paramValue := r.URL.Query().Get("typeId"); paramValue != "" {
+	// ------------- Optional query parameter "size" -------------
+	if paramValue := r.URL.Query().Get("size"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "typeId", r.URL.Query(), &params.TypeId)
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPhoto(w, r, photoId, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateVisitSlot operation middleware
+func (siw *ServerInterfaceWrapper) CreateVisitSlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVisitSlot(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteVisitSlot operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVisitSlot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "slotId" -------------
+	var slotId string
+
+	err = runtime.BindStyledParameter("simple", false, "slotId", chi.URLParam(r, "slotId"), &slotId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slotId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVisitSlot(w, r, slotId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateUser(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUser(w, r, userId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUser(w, r, userId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ListWorkerAbsences operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkerAbsences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWorkerAbsencesParams
+
+	// ------------- Optional query parameter "from" -------------
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search" -------------
-	if paramValue := r.URL.Query().Get("search"); paramValue != "" {
+	// ------------- Optional query parameter "to" -------------
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
@@ -491,7 +4631,7 @@ func (siw *ServerInterfaceWrapper) ListApplicationSubTypes(w http.ResponseWriter
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListApplicationSubTypes(w, r, params)
+		siw.Handler.ListWorkerAbsences(w, r, userId, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -501,50 +4641,75 @@ func (siw *ServerInterfaceWrapper) ListApplicationSubTypes(w http.ResponseWriter
 	handler(w, r.WithContext(ctx))
 }
 
-// ListApplicationTypes operation middleware
-func (siw *ServerInterfaceWrapper) ListApplicationTypes(w http.ResponseWriter, r *http.Request) {
+// CreateWorkerAbsence operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkerAbsence(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListApplicationTypesParams
-
-	// ------------- Optional query parameter "search" -------------
-	if paramValue := r.URL.Query().Get("search"); paramValue != "" {
-
-	}
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "pagination" -------------
-	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkerAbsence(w, r, userId)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	handler(w, r.WithContext(ctx))
+}
+
+// GetWorkerQualifications operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkerQualifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
-	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkerQualifications(w, r, userId)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	handler(w, r.WithContext(ctx))
+}
+
+// SetWorkerQualifications operation middleware
+func (siw *ServerInterfaceWrapper) SetWorkerQualifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListApplicationTypes(w, r, params)
+		siw.Handler.SetWorkerQualifications(w, r, userId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -554,12 +4719,23 @@ func (siw *ServerInterfaceWrapper) ListApplicationTypes(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// CreateUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+// GetWorkerSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkerSchedule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameter("simple", false, "userId", chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateUser(w, r)
+		siw.Handler.GetWorkerSchedule(w, r, userId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -569,8 +4745,8 @@ func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
-// DeleteUser operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Request) {
+// SetWorkerSchedule operation middleware
+func (siw *ServerInterfaceWrapper) SetWorkerSchedule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -585,7 +4761,7 @@ func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Req
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteUser(w, r, userId)
+		siw.Handler.SetWorkerSchedule(w, r, userId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -595,8 +4771,8 @@ func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
-// GetUser operation middleware
-func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+// ListVisitSlots operation middleware
+func (siw *ServerInterfaceWrapper) ListVisitSlots(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -610,8 +4786,66 @@ func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVisitSlotsParams
+
+	// ------------- Optional query parameter "from" -------------
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "available" -------------
+	if paramValue := r.URL.Query().Get("available"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "available", r.URL.Query(), &params.Available)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "available", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pagination" -------------
+	if paramValue := r.URL.Query().Get("pagination"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "pagination", r.URL.Query(), &params.Pagination)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pagination", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUser(w, r, userId)
+		siw.Handler.ListVisitSlots(w, r, userId, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -820,6 +5054,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/absence/{absenceId}", wrapper.DeleteWorkerAbsence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/apartment/{apartmentId}", wrapper.DeleteApartment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/apartment/{apartmentId}", wrapper.GetApartment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/apartment/{apartmentId}", wrapper.UpdateApartment)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/application", wrapper.CreateApplication)
 	})
@@ -829,15 +5075,177 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/application/{applicationId}", wrapper.UpdateApplication)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/accept", wrapper.AcceptApplicationAssignment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/application/{applicationId}/act", wrapper.GetApplicationAct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/assign", wrapper.AssignApplicationPerformer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/cancel", wrapper.CancelApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/application/{applicationId}/checklist", wrapper.ListApplicationChecklist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/application/{applicationId}/checklist/{itemId}", wrapper.SetApplicationChecklistItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/application/{applicationId}/comments", wrapper.ListApplicationComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/comments", wrapper.CreateApplicationComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/application/{applicationId}/comments/{commentId}", wrapper.DeleteApplicationComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/application/{applicationId}/comments/{commentId}", wrapper.UpdateApplicationComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/decline", wrapper.DeclineApplicationAssignment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/duplicate", wrapper.MarkApplicationDuplicate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/application/{applicationId}/history", wrapper.ListApplicationHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/application/{applicationId}/items", wrapper.ListApplicationLineItems)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/items", wrapper.CreateApplicationLineItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/application/{applicationId}/items/{itemId}", wrapper.DeleteApplicationLineItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/application/{applicationId}/items/{itemId}", wrapper.UpdateApplicationLineItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/rating", wrapper.RateApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/reopen", wrapper.ReopenApplication)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/slot", wrapper.BookApplicationVisitSlot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/application/{applicationId}/unassign", wrapper.UnassignApplicationPerformer)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/applications", wrapper.ListApplications)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/applications/bulk", wrapper.BulkUpdateApplications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/applications/costs", wrapper.GetApplicationCosts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/applications/ratings", wrapper.GetApplicationRatings)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/applications/subtypes", wrapper.ListApplicationSubTypes)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/applications/subtypes", wrapper.CreateApplicationSubType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/applications/subtypes/{subtypeId}", wrapper.UpdateApplicationSubType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/applications/subtypes/{subtypeId}/archived", wrapper.SetApplicationSubTypeArchived)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/applications/subtypes/{subtypeId}/checklist", wrapper.GetSubTypeChecklist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/applications/subtypes/{subtypeId}/checklist", wrapper.SetSubTypeChecklist)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/applications/types", wrapper.ListApplicationTypes)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/applications/types", wrapper.CreateApplicationType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/applications/types/order", wrapper.ReorderApplicationTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/applications/types/{typeId}", wrapper.UpdateApplicationType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/applications/types/{typeId}/archived", wrapper.SetApplicationTypeArchived)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/applications/types/{typeId}/subtypes/order", wrapper.ReorderApplicationSubTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/building", wrapper.CreateBuilding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/building/{buildingId}", wrapper.DeleteBuilding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/building/{buildingId}", wrapper.GetBuilding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/building/{buildingId}", wrapper.UpdateBuilding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/building/{buildingId}/apartments", wrapper.ListApartments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/building/{buildingId}/apartments", wrapper.CreateApartment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/building/{buildingId}/entrances", wrapper.ListEntrances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/building/{buildingId}/entrances", wrapper.CreateEntrance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/buildings", wrapper.ListBuildings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/entrance/{entranceId}", wrapper.DeleteEntrance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/entrance/{entranceId}", wrapper.UpdateEntrance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/maintenance", wrapper.CreateMaintenanceTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/maintenance/{templateId}", wrapper.DeleteMaintenanceTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/maintenance/{templateId}", wrapper.GetMaintenanceTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/maintenance/{templateId}", wrapper.UpdateMaintenanceTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/maintenances", wrapper.ListMaintenanceTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/photo", wrapper.UploadPhoto)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/photo/{photoId}", wrapper.GetPhoto)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/slot", wrapper.CreateVisitSlot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/slot/{slotId}", wrapper.DeleteVisitSlot)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/user", wrapper.CreateUser)
 	})
@@ -847,6 +5255,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/user/{userId}", wrapper.GetUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/{userId}/absences", wrapper.ListWorkerAbsences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user/{userId}/absences", wrapper.CreateWorkerAbsence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/{userId}/qualifications", wrapper.GetWorkerQualifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/user/{userId}/qualifications", wrapper.SetWorkerQualifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/{userId}/schedule", wrapper.GetWorkerSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/user/{userId}/schedule", wrapper.SetWorkerSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user/{userId}/slots", wrapper.ListVisitSlots)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users", wrapper.ListUsers)
 	})